@@ -0,0 +1,8 @@
+# Restore the most recent backup of a database.
+# Run with: terraform apply -invoke=action.ispconfig_backup_restore.shop_db
+action "ispconfig_backup_restore" "shop_db" {
+  config {
+    backup_id        = data.ispconfig_backups.shop_db.backups[0].id
+    parent_domain_id = ispconfig_web_hosting.example.id
+  }
+}
@@ -0,0 +1,14 @@
+# All backups of a website and its databases, newest first
+data "ispconfig_backups" "site" {
+  parent_domain_id = ispconfig_web_hosting.example.id
+}
+
+# Only the backups of one database
+data "ispconfig_backups" "shop_db" {
+  parent_domain_id = ispconfig_web_hosting.example.id
+  database_name    = "c1shop"
+}
+
+output "latest_web_backup" {
+  value = [for b in data.ispconfig_backups.site.backups : b if b.type == "web"][0]
+}
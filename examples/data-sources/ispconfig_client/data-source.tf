@@ -1,3 +1,14 @@
 data "ispconfig_client" "example" {
   id = 1
 }
+
+# Look up a client by the customer number of the CRM; fails if several
+# clients share it
+data "ispconfig_client" "by_customer_no" {
+  customer_no = "C1005"
+}
+
+# Or by its panel login name
+data "ispconfig_client" "by_username" {
+  username = "acme"
+}
@@ -0,0 +1,7 @@
+data "ispconfig_client_template" "pro" {
+  name = "Pro"
+}
+
+output "pro_web_domains" {
+  value = data.ispconfig_client_template.pro.limits["limit_web_domain"]
+}
@@ -0,0 +1,16 @@
+# The latest PHP 8.x available for PHP-FPM on the web server
+data "ispconfig_php_versions" "fpm8" {
+  server_id = 1
+  handler   = "php-fpm"
+  prefix    = "8."
+}
+
+resource "ispconfig_web_hosting" "example" {
+  domain      = "example.com"
+  php         = "php-fpm"
+  php_version = data.ispconfig_php_versions.fpm8.latest
+}
+
+output "available_php_versions" {
+  value = [for v in data.ispconfig_php_versions.fpm8.versions : v.version]
+}
@@ -0,0 +1,6 @@
+# Lists the clients of the provider reseller_id, or of the given reseller_id
+data "ispconfig_reseller_clients" "mine" {}
+
+output "client_usernames" {
+  value = { for c in data.ispconfig_reseller_clients.mine.clients : c.id => c.username }
+}
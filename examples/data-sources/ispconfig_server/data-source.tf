@@ -0,0 +1,9 @@
+# Select the server by name instead of hard-coding its ID
+data "ispconfig_server" "web" {
+  name = "web1.example.com"
+}
+
+resource "ispconfig_web_hosting" "example" {
+  domain    = "example.com"
+  server_id = data.ispconfig_server.web.id
+}
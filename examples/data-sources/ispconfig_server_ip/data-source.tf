@@ -0,0 +1,9 @@
+data "ispconfig_server_ip" "example" {
+  ip_address = "192.0.2.10"
+}
+
+# The address may be configured on several servers; narrow the lookup
+data "ispconfig_server_ip" "on_web2" {
+  server_id  = 2
+  ip_address = "192.0.2.10"
+}
@@ -0,0 +1,10 @@
+# IPv4 addresses web sites on server 1 may use
+data "ispconfig_server_ips" "web" {
+  server_id   = 1
+  ip_type     = "IPv4"
+  virtualhost = true
+}
+
+output "web_ips" {
+  value = [for ip in data.ispconfig_server_ips.web.ips : ip.ip_address]
+}
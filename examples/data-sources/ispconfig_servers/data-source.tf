@@ -0,0 +1,17 @@
+# All mail servers of the installation
+data "ispconfig_servers" "mail" {
+  role = "mail"
+}
+
+output "mail_servers" {
+  value = { for s in data.ispconfig_servers.mail.servers : s.name => s.id }
+}
+
+# Primary web servers (not mirrors of another server)
+data "ispconfig_servers" "web" {
+  role = "web"
+}
+
+locals {
+  primary_web_servers = [for s in data.ispconfig_servers.web.servers : s.id if s.mirror_server_id == 0]
+}
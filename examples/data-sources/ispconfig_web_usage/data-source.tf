@@ -0,0 +1,15 @@
+data "ispconfig_web_usage" "customer" {
+  client_id = 5
+}
+
+# Sites that used more than 80% of their monthly traffic quota
+output "traffic_warnings" {
+  value = [
+    for site in data.ispconfig_web_usage.customer.sites : site.domain
+    if site.traffic_quota > 0 && site.traffic_this_month > site.traffic_quota * 0.8
+  ]
+}
+
+output "disk_usage" {
+  value = { for site in data.ispconfig_web_usage.customer.sites : site.domain => "${site.hd_used} / ${site.hd_quota} MB" }
+}
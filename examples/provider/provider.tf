@@ -17,6 +17,12 @@ provider "ispconfig" {
   # Required: ISPConfig server host and port
   host = var.ispconfig_host
 
+  # Alternative to host: the full remote API URL, e.g. behind a reverse proxy
+  # endpoint = "https://panel.example.com/ispconfig/remote/json.php"
+
+  # Optional: Send API requests through a proxy
+  # proxy_url = "http://proxy.example.com:3128"
+
   # Required: Authentication credentials
   username = var.ispconfig_username
   password = var.ispconfig_password
@@ -24,11 +30,28 @@ provider "ispconfig" {
   # Optional: Skip TLS certificate verification (for self-signed certificates)
   # insecure = true
 
+  # Optional: Trust an internal CA instead of skipping verification
+  # ca_cert_file = "${path.module}/certs/internal-ca.crt"
+
+  # Optional: Client certificate for an mTLS gateway in front of the panel
+  # client_cert = file("${path.module}/certs/terraform.crt")
+  # client_key  = file("${path.module}/certs/terraform.key")
+
   # Optional: Default client ID for all resources
   # client_id = 1
 
   # Optional: Default server ID for all resources (typically 1 for single-server setups)
   # server_id = 1
+
+  # Optional: Reseller whose clients resources may act on
+  # reseller_id = 3
+
+  # Optional: Only finish a change once the servers have applied it
+  # wait_for_jobqueue = true
+  # jobqueue_timeout  = "5m"
+
+  # Optional: How long a single API request may take
+  # request_timeout = "30s"
 }
 
 # Input variables for provider configuration
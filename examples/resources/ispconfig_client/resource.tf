@@ -0,0 +1,51 @@
+resource "ispconfig_client" "acme" {
+  company_name = "ACME Corp."
+  contact_name = "Jane Doe"
+  email        = "jane@acme.example"
+  country      = "DE"
+
+  username         = "acme"
+  password         = var.acme_panel_password
+  password_version = 1
+
+  limit_web        = 5
+  limit_web_quota  = 20000
+  limit_database   = 5
+  limit_shell_user = 1
+  limit_mailbox    = 50
+}
+
+# A client on a hosting package: upgrading is a one-line change of the name
+data "ispconfig_client_template" "pro" {
+  name = "Pro"
+}
+
+data "ispconfig_client_template" "extra_mailboxes" {
+  name = "Extra Mailboxes"
+}
+
+resource "ispconfig_client" "globex" {
+  contact_name = "John Smith"
+  email        = "john@globex.example"
+  username     = "globex"
+  password     = var.globex_panel_password
+
+  template_master     = data.ispconfig_client_template.pro.id
+  template_additional = [data.ispconfig_client_template.extra_mailboxes.id]
+}
+
+variable "acme_panel_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "globex_panel_password" {
+  type      = string
+  sensitive = true
+}
+
+# Create the client's resources with its ID
+resource "ispconfig_web_hosting" "acme" {
+  client_id = ispconfig_client.acme.id
+  domain    = "acme.example"
+}
@@ -0,0 +1,13 @@
+resource "ispconfig_ftp_user" "agency" {
+  parent_domain_id = 1
+  username         = "agency"
+  password         = var.ftp_password
+  quota_size       = 2000
+  expires          = "2026-12-31T23:59:59Z"
+  active           = true
+}
+
+variable "ftp_password" {
+  type      = string
+  sensitive = true
+}
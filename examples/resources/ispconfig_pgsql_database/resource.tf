@@ -3,4 +3,6 @@ resource "ispconfig_pgsql_database" "example" {
   parent_domain_id = 1
   active           = true
   quota            = 500
+  backup_interval  = "daily"
+  backup_copies    = 7
 }
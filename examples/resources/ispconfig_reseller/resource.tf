@@ -0,0 +1,32 @@
+resource "ispconfig_reseller" "partner" {
+  company_name = "Partner Hosting Ltd."
+  contact_name = "Alex Miller"
+  email        = "alex@partner.example"
+
+  username = "partner"
+  password = var.partner_panel_password
+
+  # A reseller may create up to 20 clients of its own (-1 for unlimited)
+  limit_client   = 20
+  limit_web      = 50
+  limit_database = 50
+}
+
+variable "partner_panel_password" {
+  type      = string
+  sensitive = true
+}
+
+# Clients created below the reseller
+resource "ispconfig_client" "customer" {
+  parent_client_id = ispconfig_reseller.partner.id
+  contact_name     = "Jane Doe"
+  email            = "jane@customer.example"
+  username         = "customer"
+  password         = var.customer_panel_password
+}
+
+variable "customer_panel_password" {
+  type      = string
+  sensitive = true
+}
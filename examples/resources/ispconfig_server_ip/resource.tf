@@ -0,0 +1,20 @@
+# An additional address web sites of the server may use
+resource "ispconfig_server_ip" "web" {
+  server_id  = 1
+  ip_address = "192.0.2.20"
+}
+
+# A dedicated IPv6 address reserved for one client
+resource "ispconfig_server_ip" "acme_v6" {
+  server_id  = 1
+  client_id  = 5
+  ip_address = "2001:db8::20"
+}
+
+resource "ispconfig_web_hosting" "acme" {
+  domain       = "acme.example"
+  server_id    = 1
+  client_id    = 5
+  ip_address   = ispconfig_server_ip.web.ip_address
+  ipv6_address = ispconfig_server_ip.acme_v6.ip_address
+}
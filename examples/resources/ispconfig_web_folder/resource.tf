@@ -0,0 +1,6 @@
+# Protect the whole staging site with HTTP basic auth
+resource "ispconfig_web_folder" "staging" {
+  parent_domain_id = 1
+  path             = "/"
+  active           = true
+}
@@ -0,0 +1,13 @@
+resource "ispconfig_web_folder_user" "reviewer" {
+  web_folder_id    = ispconfig_web_folder.staging.id
+  username         = "reviewer"
+  password         = var.staging_password
+  password_version = 1
+  active           = true
+}
+
+variable "staging_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
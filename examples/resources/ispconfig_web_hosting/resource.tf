@@ -7,4 +7,52 @@ resource "ispconfig_web_hosting" "example" {
   active      = true
   ssl         = true
   hd_quota    = 10000
+
+  backup_interval = "weekly"
+  backup_copies   = 4
+
+  # Statistics at https://example.com/stats (user "admin")
+  stats_type     = "goaccess"
+  stats_password = var.stats_password
+
+  # PHP-FPM pool tuning
+  pm                   = "dynamic"
+  pm_max_children      = 20
+  pm_start_servers     = 4
+  pm_min_spare_servers = 2
+  pm_max_spare_servers = 6
+
+  custom_php_ini = {
+    memory_limit        = "256M"
+    upload_max_filesize = "64M"
+  }
+
+  # Request a Let's Encrypt certificate and wait until it is issued
+  ssl_letsencrypt          = true
+  wait_for_ssl_certificate = true
+
+  # Deleting a large site can take longer than the default of 20 minutes
+  timeouts {
+    delete = "45m"
+  }
+}
+
+variable "stats_password" {
+  type      = string
+  sensitive = true
+}
+
+output "ssl_cert_expiry" {
+  value = ispconfig_web_hosting.example.ssl_cert_expiry
+}
+
+# Install a certificate issued by an internal CA instead of Let's Encrypt
+resource "ispconfig_web_hosting" "intranet" {
+  domain    = "intranet.example.com"
+  server_id = 1
+  ssl       = true
+
+  ssl_certificate = file("${path.module}/certs/intranet.crt")
+  ssl_chain       = file("${path.module}/certs/internal-ca.crt")
+  ssl_private_key = file("${path.module}/certs/intranet.key")
 }
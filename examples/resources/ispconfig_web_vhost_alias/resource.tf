@@ -0,0 +1,7 @@
+resource "ispconfig_web_vhost_alias" "example" {
+  parent_domain_id = 1
+  domain           = "example.net"
+  web_folder       = "example-net"
+  php              = "php-fpm"
+  php_version      = "8.3"
+}
@@ -0,0 +1,14 @@
+resource "ispconfig_web_hosting" "production" {
+  domain      = "example.com"
+  php         = "php-fpm"
+  php_version = "8.2"
+}
+
+# Staging runs a newer PHP version than production
+resource "ispconfig_web_vhost_subdomain" "staging" {
+  parent_domain_id = ispconfig_web_hosting.production.id
+  domain           = "staging.example.com"
+  web_folder       = "staging"
+  php              = "php-fpm"
+  php_version      = "8.4"
+}
@@ -0,0 +1,16 @@
+resource "ispconfig_webdav_user" "editor" {
+  parent_domain_id = 1
+  username         = "editor"
+  password         = var.webdav_password
+  dir              = "editor"
+  active           = true
+}
+
+output "webdav_login" {
+  value = ispconfig_webdav_user.editor.login
+}
+
+variable "webdav_password" {
+  type      = string
+  sensitive = true
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -21,9 +24,18 @@ type Client struct {
 	password   string
 	sessionID  string
 	httpClient *http.Client
+	transport  *http.Transport
 	mu         sync.RWMutex
+
+	// jobqueueTimeout is how long WaitForJobqueue waits; 0 disables it
+	jobqueueTimeout  time.Duration
+	jobqueueInterval time.Duration
 }
 
+// defaultRequestTimeout limits a single API request unless SetRequestTimeout
+// is called. Login, Logout and data source reads have no other deadline.
+const defaultRequestTimeout = 30 * time.Second
+
 // NewClient creates a new ISP Config API client
 func NewClient(host, username, password string, insecure bool) *Client {
 	transport := &http.Transport{
@@ -37,10 +49,85 @@ func NewClient(host, username, password string, insecure bool) *Client {
 		username: username,
 		password: password,
 		httpClient: &http.Client{
-			Timeout:   30 * time.Second,
+			Timeout:   defaultRequestTimeout,
 			Transport: transport,
 		},
+		transport: transport,
+	}
+}
+
+// SetEndpoint points the client at the remote API URL instead of
+// https://<host>/remote/json.php, e.g. for a panel served below a path by a
+// reverse proxy or over plain HTTP. If the URL does not name the PHP script,
+// /remote/json.php is appended to its path.
+func (c *Client) SetEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("endpoint URL must use http or https, got %q", endpoint)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("endpoint URL has no host: %q", endpoint)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("endpoint URL must not have a query or fragment: %q", endpoint)
+	}
+	if !strings.HasSuffix(u.Path, ".php") {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/remote/json.php"
+	}
+	c.baseURL = u.String()
+	return nil
+}
+
+// SetProxy sends all API requests through the HTTP(S) or SOCKS5 proxy at
+// proxyURL. Without it no proxy is used.
+func (c *Client) SetProxy(proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5" {
+		return fmt.Errorf("proxy URL must use http, https or socks5, got %q", proxyURL)
 	}
+	if u.Host == "" {
+		return fmt.Errorf("proxy URL has no host: %q", proxyURL)
+	}
+	c.transport.Proxy = http.ProxyURL(u)
+	return nil
+}
+
+// SetCACertificates makes the client trust the PEM encoded CA certificates in
+// addition to the system roots, e.g. for a panel using an internal CA.
+func (c *Client) SetCACertificates(pemCerts []byte) error {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return fmt.Errorf("no valid PEM encoded certificates found")
+	}
+	c.transport.TLSClientConfig.RootCAs = pool
+	return nil
+}
+
+// SetClientCertificate makes the client present the PEM encoded certificate
+// and private key, for servers that require mutual TLS.
+func (c *Client) SetClientCertificate(certPEM, keyPEM []byte) error {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	c.transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	return nil
+}
+
+// SetRequestTimeout limits how long a single API request may take (default
+// 30 seconds). Requests also end when the context passed to them is done,
+// whichever comes first.
+func (c *Client) SetRequestTimeout(timeout time.Duration) {
+	c.httpClient.Timeout = timeout
 }
 
 // Login authenticates with the ISP Config API and stores the session ID
@@ -141,6 +228,52 @@ func (c *Client) makeRequest(ctx context.Context, method string, params map[stri
 	return nil
 }
 
+// SetJobqueueWait makes WaitForJobqueue wait up to timeout for the servers to
+// process pending changes. A timeout of 0 disables waiting.
+func (c *Client) SetJobqueueWait(timeout time.Duration) {
+	c.jobqueueTimeout = timeout
+	c.jobqueueInterval = 5 * time.Second
+}
+
+// WaitForJobqueue polls the job queue until the server has applied all
+// changes written so far, or all servers if serverID is 0. ISPConfig records
+// every change in sys_datalog and the server daemons apply them
+// asynchronously (by default every minute). It returns immediately unless
+// enabled with SetJobqueueWait.
+func (c *Client) WaitForJobqueue(ctx context.Context, serverID int) error {
+	if c.jobqueueTimeout == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.jobqueueTimeout)
+	defer cancel()
+
+	pending := 0
+	for {
+		count, err := c.GetJobqueueCount(ctx, serverID)
+		switch {
+		case err == nil && count == 0:
+			return nil
+		case err == nil:
+			pending = count
+		case ctx.Err() == nil:
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.Canceled {
+				return ctx.Err()
+			}
+			if serverID != 0 {
+				return fmt.Errorf("job queue of server %d not processed after %s: %d changes still pending", serverID, c.jobqueueTimeout, pending)
+			}
+			return fmt.Errorf("job queue not processed after %s: %d changes still pending", c.jobqueueTimeout, pending)
+		case <-time.After(c.jobqueueInterval):
+		}
+	}
+}
+
 // getSessionID returns the current session ID
 func (c *Client) getSessionID() string {
 	c.mu.RLock()
@@ -177,6 +310,54 @@ func unmarshalResponse(response interface{}, target interface{}) error {
 	return nil
 }
 
+// unmarshalRecords unmarshals a list of records. PHP encodes arrays with
+// non-sequential keys as JSON objects and empty results as false, so both are
+// accepted in addition to a JSON array.
+func unmarshalRecords(response interface{}, target interface{}) error {
+	switch r := response.(type) {
+	case bool, nil:
+		response = []interface{}{}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(r))
+		for k := range r {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		records := make([]interface{}, 0, len(r))
+		for _, k := range keys {
+			records = append(records, r[k])
+		}
+		response = records
+	}
+	return unmarshalResponse(response, target)
+}
+
+// GetJobqueueCount returns the number of changes the server has not processed
+// yet, or the total of all servers if serverID is 0
+func (c *Client) GetJobqueueCount(ctx context.Context, serverID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"server_id":  serverID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "monitor_jobqueue_count", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get job queue count: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to get job queue count: %s", response.Message)
+	}
+
+	var count FlexInt
+	if err := unmarshalResponse(response.Response, &count); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal job queue count: %w", err)
+	}
+
+	return int(count), nil
+}
+
 // Web Domain methods
 
 // AddWebDomain creates a new web domain
@@ -267,669 +448,1915 @@ func (c *Client) DeleteWebDomain(ctx context.Context, domainID int) error {
 	return nil
 }
 
-// Shell User methods
+// Vhost Subdomain methods
 
-// AddShellUser creates a new shell user
-func (c *Client) AddShellUser(ctx context.Context, shellUser *ShellUser, clientID int) (int, error) {
+// AddWebVhostSubdomain creates a new vhost subdomain
+func (c *Client) AddWebVhostSubdomain(ctx context.Context, domain *WebDomain, clientID int) (int, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"params":     shellUser,
+		"params":     domain,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_shell_user_add", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_subdomain_add", params, &response)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add shell user: %w", err)
+		return 0, fmt.Errorf("failed to add vhost subdomain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return 0, fmt.Errorf("failed to add shell user: %s", response.Message)
+		return 0, fmt.Errorf("failed to add vhost subdomain: %s", response.Message)
 	}
 
 	return parseResponseID(response.Response)
 }
 
-// GetShellUser retrieves a shell user by ID
-func (c *Client) GetShellUser(ctx context.Context, shellUserID int) (*ShellUser, error) {
+// GetWebVhostSubdomain retrieves a vhost subdomain by ID
+func (c *Client) GetWebVhostSubdomain(ctx context.Context, domainID int) (*WebDomain, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": shellUserID,
+		"primary_id": domainID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_shell_user_get", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_subdomain_get", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get shell user: %w", err)
+		return nil, fmt.Errorf("failed to get vhost subdomain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get shell user: %s", response.Message)
+		return nil, fmt.Errorf("failed to get vhost subdomain: %s", response.Message)
 	}
 
-	var shellUser ShellUser
-	if err := unmarshalResponse(response.Response, &shellUser); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal shell user: %w", err)
+	var domain WebDomain
+	if err := unmarshalResponse(response.Response, &domain); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal vhost subdomain: %w", err)
 	}
 
-	return &shellUser, nil
+	return &domain, nil
 }
 
-// UpdateShellUser updates a shell user
-func (c *Client) UpdateShellUser(ctx context.Context, shellUserID int, clientID int, shellUser *ShellUser) error {
+// UpdateWebVhostSubdomain updates a vhost subdomain
+func (c *Client) UpdateWebVhostSubdomain(ctx context.Context, domainID int, clientID int, domain *WebDomain) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"primary_id": shellUserID,
-		"params":     shellUser,
+		"primary_id": domainID,
+		"params":     domain,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_shell_user_update", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_subdomain_update", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to update shell user: %w", err)
+		return fmt.Errorf("failed to update vhost subdomain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to update shell user: %s", response.Message)
+		return fmt.Errorf("failed to update vhost subdomain: %s", response.Message)
 	}
 
 	return nil
 }
 
-// DeleteShellUser deletes a shell user
-func (c *Client) DeleteShellUser(ctx context.Context, shellUserID int) error {
+// DeleteWebVhostSubdomain deletes a vhost subdomain
+func (c *Client) DeleteWebVhostSubdomain(ctx context.Context, domainID int) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": shellUserID,
+		"primary_id": domainID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_shell_user_delete", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_subdomain_delete", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to delete shell user: %w", err)
+		return fmt.Errorf("failed to delete vhost subdomain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to delete shell user: %s", response.Message)
+		return fmt.Errorf("failed to delete vhost subdomain: %s", response.Message)
 	}
 
 	return nil
 }
 
-// Database methods
+// Vhost Alias Domain methods
 
-// AddDatabase creates a new database
-func (c *Client) AddDatabase(ctx context.Context, database *Database, clientID int) (int, error) {
+// AddWebVhostAliasDomain creates a new vhost alias domain
+func (c *Client) AddWebVhostAliasDomain(ctx context.Context, domain *WebDomain, clientID int) (int, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"params":     database,
+		"params":     domain,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_add", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_aliasdomain_add", params, &response)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add database: %w", err)
+		return 0, fmt.Errorf("failed to add vhost alias domain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return 0, fmt.Errorf("failed to add database: %s", response.Message)
+		return 0, fmt.Errorf("failed to add vhost alias domain: %s", response.Message)
 	}
 
 	return parseResponseID(response.Response)
 }
 
-// GetDatabase retrieves a database by ID
-func (c *Client) GetDatabase(ctx context.Context, databaseID int) (*Database, error) {
+// GetWebVhostAliasDomain retrieves a vhost alias domain by ID
+func (c *Client) GetWebVhostAliasDomain(ctx context.Context, domainID int) (*WebDomain, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": databaseID,
+		"primary_id": domainID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_get", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_aliasdomain_get", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get database: %w", err)
+		return nil, fmt.Errorf("failed to get vhost alias domain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get database: %s", response.Message)
+		return nil, fmt.Errorf("failed to get vhost alias domain: %s", response.Message)
 	}
 
-	var database Database
-	if err := unmarshalResponse(response.Response, &database); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal database: %w", err)
+	var domain WebDomain
+	if err := unmarshalResponse(response.Response, &domain); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal vhost alias domain: %w", err)
 	}
 
-	return &database, nil
+	return &domain, nil
 }
 
-// UpdateDatabase updates a database
-func (c *Client) UpdateDatabase(ctx context.Context, databaseID int, clientID int, database *Database) error {
+// UpdateWebVhostAliasDomain updates a vhost alias domain
+func (c *Client) UpdateWebVhostAliasDomain(ctx context.Context, domainID int, clientID int, domain *WebDomain) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"primary_id": databaseID,
-		"params":     database,
+		"primary_id": domainID,
+		"params":     domain,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_update", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_aliasdomain_update", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to update database: %w", err)
+		return fmt.Errorf("failed to update vhost alias domain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to update database: %s", response.Message)
+		return fmt.Errorf("failed to update vhost alias domain: %s", response.Message)
 	}
 
 	return nil
 }
 
-// DeleteDatabase deletes a database
-func (c *Client) DeleteDatabase(ctx context.Context, databaseID int) error {
+// DeleteWebVhostAliasDomain deletes a vhost alias domain
+func (c *Client) DeleteWebVhostAliasDomain(ctx context.Context, domainID int) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": databaseID,
+		"primary_id": domainID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_delete", params, &response)
+	err := c.makeRequest(ctx, "sites_web_vhost_aliasdomain_delete", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to delete database: %w", err)
+		return fmt.Errorf("failed to delete vhost alias domain: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to delete database: %s", response.Message)
+		return fmt.Errorf("failed to delete vhost alias domain: %s", response.Message)
 	}
 
 	return nil
 }
 
-// Database User methods
+// Shell User methods
 
-// AddDatabaseUser creates a new database user
-func (c *Client) AddDatabaseUser(ctx context.Context, dbUser *DatabaseUser, clientID int) (int, error) {
+// AddShellUser creates a new shell user
+func (c *Client) AddShellUser(ctx context.Context, shellUser *ShellUser, clientID int) (int, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"params":     dbUser,
+		"params":     shellUser,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_user_add", params, &response)
+	err := c.makeRequest(ctx, "sites_shell_user_add", params, &response)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add database user: %w", err)
+		return 0, fmt.Errorf("failed to add shell user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return 0, fmt.Errorf("failed to add database user: %s", response.Message)
+		return 0, fmt.Errorf("failed to add shell user: %s", response.Message)
 	}
 
 	return parseResponseID(response.Response)
 }
 
-// GetDatabaseUser retrieves a database user by ID
-func (c *Client) GetDatabaseUser(ctx context.Context, dbUserID int) (*DatabaseUser, error) {
+// GetShellUser retrieves a shell user by ID
+func (c *Client) GetShellUser(ctx context.Context, shellUserID int) (*ShellUser, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": dbUserID,
+		"primary_id": shellUserID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_user_get", params, &response)
+	err := c.makeRequest(ctx, "sites_shell_user_get", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get database user: %w", err)
+		return nil, fmt.Errorf("failed to get shell user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get database user: %s", response.Message)
+		return nil, fmt.Errorf("failed to get shell user: %s", response.Message)
 	}
 
-	var dbUser DatabaseUser
-	if err := unmarshalResponse(response.Response, &dbUser); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal database user: %w", err)
+	var shellUser ShellUser
+	if err := unmarshalResponse(response.Response, &shellUser); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal shell user: %w", err)
 	}
 
-	return &dbUser, nil
+	return &shellUser, nil
 }
 
-// UpdateDatabaseUser updates a database user
-func (c *Client) UpdateDatabaseUser(ctx context.Context, dbUserID int, clientID int, dbUser *DatabaseUser) error {
+// UpdateShellUser updates a shell user
+func (c *Client) UpdateShellUser(ctx context.Context, shellUserID int, clientID int, shellUser *ShellUser) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"primary_id": dbUserID,
-		"params":     dbUser,
+		"primary_id": shellUserID,
+		"params":     shellUser,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_user_update", params, &response)
+	err := c.makeRequest(ctx, "sites_shell_user_update", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to update database user: %w", err)
+		return fmt.Errorf("failed to update shell user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to update database user: %s", response.Message)
+		return fmt.Errorf("failed to update shell user: %s", response.Message)
 	}
 
 	return nil
 }
 
-// DeleteDatabaseUser deletes a database user
-func (c *Client) DeleteDatabaseUser(ctx context.Context, dbUserID int) error {
+// DeleteShellUser deletes a shell user
+func (c *Client) DeleteShellUser(ctx context.Context, shellUserID int) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": dbUserID,
+		"primary_id": shellUserID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_database_user_delete", params, &response)
+	err := c.makeRequest(ctx, "sites_shell_user_delete", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to delete database user: %w", err)
+		return fmt.Errorf("failed to delete shell user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to delete database user: %s", response.Message)
+		return fmt.Errorf("failed to delete shell user: %s", response.Message)
 	}
 
 	return nil
 }
 
-// Cron Job methods
+// FTP User methods
 
-// AddCronJob creates a new cron task
-func (c *Client) AddCronJob(ctx context.Context, cronJob *CronJob, clientID int) (int, error) {
+// AddFTPUser creates a new FTP user
+func (c *Client) AddFTPUser(ctx context.Context, ftpUser *FTPUser, clientID int) (int, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"params":     cronJob,
+		"params":     ftpUser,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_cron_add", params, &response)
+	err := c.makeRequest(ctx, "sites_ftp_user_add", params, &response)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add cron job: %w", err)
+		return 0, fmt.Errorf("failed to add FTP user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return 0, fmt.Errorf("failed to add cron job: %s", response.Message)
+		return 0, fmt.Errorf("failed to add FTP user: %s", response.Message)
 	}
 
 	return parseResponseID(response.Response)
 }
 
-// GetCronJob retrieves a cron job by ID
-func (c *Client) GetCronJob(ctx context.Context, cronJobID int) (*CronJob, error) {
+// GetFTPUser retrieves an FTP user by ID
+func (c *Client) GetFTPUser(ctx context.Context, ftpUserID int) (*FTPUser, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"cron_id":    cronJobID,
+		"primary_id": ftpUserID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_cron_get", params, &response)
+	err := c.makeRequest(ctx, "sites_ftp_user_get", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cron job: %w", err)
+		return nil, fmt.Errorf("failed to get FTP user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get cron job: %s", response.Message)
-	}
-
-	jsonData, err := json.Marshal(response.Response)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	// ISPConfig may return an array ([{...}] or []) instead of a plain object
-	if len(jsonData) > 0 && jsonData[0] == '[' {
-		var arr []json.RawMessage
-		if err := json.Unmarshal(jsonData, &arr); err != nil || len(arr) == 0 {
-			return nil, fmt.Errorf("cron job not found (id: %d)", cronJobID)
-		}
-		jsonData = arr[0]
+		return nil, fmt.Errorf("failed to get FTP user: %s", response.Message)
 	}
 
-	var cronJob CronJob
-	err = json.Unmarshal(jsonData, &cronJob)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal cron job: %w", err)
+	var ftpUser FTPUser
+	if err := unmarshalResponse(response.Response, &ftpUser); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal FTP user: %w", err)
 	}
 
-	return &cronJob, nil
+	return &ftpUser, nil
 }
 
-// UpdateCronJob updates a cron job
-func (c *Client) UpdateCronJob(ctx context.Context, cronJobID int, clientID int, cronJob *CronJob) error {
+// UpdateFTPUser updates an FTP user
+func (c *Client) UpdateFTPUser(ctx context.Context, ftpUserID int, clientID int, ftpUser *FTPUser) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"cron_id":    cronJobID,
-		"params":     cronJob,
+		"primary_id": ftpUserID,
+		"params":     ftpUser,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_cron_update", params, &response)
+	err := c.makeRequest(ctx, "sites_ftp_user_update", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to update cron job: %w", err)
+		return fmt.Errorf("failed to update FTP user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to update cron job: %s", response.Message)
+		return fmt.Errorf("failed to update FTP user: %s", response.Message)
 	}
 
 	return nil
 }
 
-// DeleteCronJob deletes a cron job
-func (c *Client) DeleteCronJob(ctx context.Context, cronJobID int) error {
+// DeleteFTPUser deletes an FTP user
+func (c *Client) DeleteFTPUser(ctx context.Context, ftpUserID int) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"cron_id":    cronJobID,
+		"primary_id": ftpUserID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "sites_cron_delete", params, &response)
+	err := c.makeRequest(ctx, "sites_ftp_user_delete", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to delete cron job: %w", err)
+		return fmt.Errorf("failed to delete FTP user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to delete cron job: %s", response.Message)
+		return fmt.Errorf("failed to delete FTP user: %s", response.Message)
 	}
 
 	return nil
 }
 
-// Mail Domain methods
+// WebDAV User methods
 
-// AddMailDomain creates a new mail domain
-func (c *Client) AddMailDomain(ctx context.Context, mailDomain *MailDomain, clientID int) (int, error) {
+// AddWebDAVUser creates a new WebDAV user
+func (c *Client) AddWebDAVUser(ctx context.Context, webdavUser *WebDAVUser, clientID int) (int, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"params":     mailDomain,
+		"params":     webdavUser,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_domain_add", params, &response)
+	err := c.makeRequest(ctx, "sites_webdav_user_add", params, &response)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add mail domain: %w", err)
+		return 0, fmt.Errorf("failed to add WebDAV user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return 0, fmt.Errorf("failed to add mail domain: %s", response.Message)
+		return 0, fmt.Errorf("failed to add WebDAV user: %s", response.Message)
 	}
 
 	return parseResponseID(response.Response)
 }
 
-// GetMailDomain retrieves a mail domain by ID
-func (c *Client) GetMailDomain(ctx context.Context, mailDomainID int) (*MailDomain, error) {
+// GetWebDAVUser retrieves a WebDAV user by ID
+func (c *Client) GetWebDAVUser(ctx context.Context, webdavUserID int) (*WebDAVUser, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": mailDomainID,
+		"primary_id": webdavUserID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_domain_get", params, &response)
+	err := c.makeRequest(ctx, "sites_webdav_user_get", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get mail domain: %w", err)
+		return nil, fmt.Errorf("failed to get WebDAV user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get mail domain: %s", response.Message)
+		return nil, fmt.Errorf("failed to get WebDAV user: %s", response.Message)
 	}
 
-	var mailDomain MailDomain
-	if err := unmarshalResponse(response.Response, &mailDomain); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal mail domain: %w", err)
+	var webdavUser WebDAVUser
+	if err := unmarshalResponse(response.Response, &webdavUser); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal WebDAV user: %w", err)
 	}
 
-	return &mailDomain, nil
+	return &webdavUser, nil
 }
 
-// UpdateMailDomain updates a mail domain
-func (c *Client) UpdateMailDomain(ctx context.Context, mailDomainID int, clientID int, mailDomain *MailDomain) error {
+// UpdateWebDAVUser updates a WebDAV user
+func (c *Client) UpdateWebDAVUser(ctx context.Context, webdavUserID int, clientID int, webdavUser *WebDAVUser) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"primary_id": mailDomainID,
-		"params":     mailDomain,
+		"primary_id": webdavUserID,
+		"params":     webdavUser,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_domain_update", params, &response)
+	err := c.makeRequest(ctx, "sites_webdav_user_update", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to update mail domain: %w", err)
+		return fmt.Errorf("failed to update WebDAV user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to update mail domain: %s", response.Message)
+		return fmt.Errorf("failed to update WebDAV user: %s", response.Message)
 	}
 
 	return nil
 }
 
-// DeleteMailDomain deletes a mail domain
-func (c *Client) DeleteMailDomain(ctx context.Context, mailDomainID int) error {
+// DeleteWebDAVUser deletes a WebDAV user
+func (c *Client) DeleteWebDAVUser(ctx context.Context, webdavUserID int) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": mailDomainID,
+		"primary_id": webdavUserID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_domain_delete", params, &response)
+	err := c.makeRequest(ctx, "sites_webdav_user_delete", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to delete mail domain: %w", err)
+		return fmt.Errorf("failed to delete WebDAV user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to delete mail domain: %s", response.Message)
+		return fmt.Errorf("failed to delete WebDAV user: %s", response.Message)
 	}
 
 	return nil
 }
 
-// Mail User methods
+// Web Folder methods
 
-// AddMailUser creates a new mail user (mailbox)
-func (c *Client) AddMailUser(ctx context.Context, mailUser *MailUser, clientID int) (int, error) {
+// AddWebFolder creates a new protected web folder
+func (c *Client) AddWebFolder(ctx context.Context, webFolder *WebFolder, clientID int) (int, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"params":     mailUser,
+		"params":     webFolder,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_user_add", params, &response)
+	err := c.makeRequest(ctx, "sites_web_folder_add", params, &response)
 	if err != nil {
-		return 0, fmt.Errorf("failed to add mail user: %w", err)
+		return 0, fmt.Errorf("failed to add web folder: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return 0, fmt.Errorf("failed to add mail user: %s", response.Message)
+		return 0, fmt.Errorf("failed to add web folder: %s", response.Message)
 	}
 
 	return parseResponseID(response.Response)
 }
 
-// GetMailUser retrieves a mail user by ID
-func (c *Client) GetMailUser(ctx context.Context, mailUserID int) (*MailUser, error) {
+// GetWebFolder retrieves a web folder by ID
+func (c *Client) GetWebFolder(ctx context.Context, webFolderID int) (*WebFolder, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": mailUserID,
+		"primary_id": webFolderID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_user_get", params, &response)
+	err := c.makeRequest(ctx, "sites_web_folder_get", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get mail user: %w", err)
+		return nil, fmt.Errorf("failed to get web folder: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get mail user: %s", response.Message)
+		return nil, fmt.Errorf("failed to get web folder: %s", response.Message)
 	}
 
-	var mailUser MailUser
-	if err := unmarshalResponse(response.Response, &mailUser); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal mail user: %w", err)
+	var webFolder WebFolder
+	if err := unmarshalResponse(response.Response, &webFolder); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal web folder: %w", err)
 	}
 
-	return &mailUser, nil
+	return &webFolder, nil
 }
 
-// UpdateMailUser updates a mail user (mailbox)
-func (c *Client) UpdateMailUser(ctx context.Context, mailUserID int, clientID int, mailUser *MailUser) error {
+// UpdateWebFolder updates a web folder
+func (c *Client) UpdateWebFolder(ctx context.Context, webFolderID int, clientID int, webFolder *WebFolder) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
-		"primary_id": mailUserID,
-		"params":     mailUser,
+		"primary_id": webFolderID,
+		"params":     webFolder,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_user_update", params, &response)
+	err := c.makeRequest(ctx, "sites_web_folder_update", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to update mail user: %w", err)
+		return fmt.Errorf("failed to update web folder: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to update mail user: %s", response.Message)
+		return fmt.Errorf("failed to update web folder: %s", response.Message)
 	}
 
 	return nil
 }
 
-// DeleteMailUser deletes a mail user (mailbox)
-func (c *Client) DeleteMailUser(ctx context.Context, mailUserID int) error {
+// DeleteWebFolder deletes a web folder
+func (c *Client) DeleteWebFolder(ctx context.Context, webFolderID int) error {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"primary_id": mailUserID,
+		"primary_id": webFolderID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "mail_user_delete", params, &response)
+	err := c.makeRequest(ctx, "sites_web_folder_delete", params, &response)
 	if err != nil {
-		return fmt.Errorf("failed to delete mail user: %w", err)
+		return fmt.Errorf("failed to delete web folder: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return fmt.Errorf("failed to delete mail user: %s", response.Message)
+		return fmt.Errorf("failed to delete web folder: %s", response.Message)
 	}
 
 	return nil
 }
 
-// Server methods
+// Web Folder User methods
 
-// GetPHPVersions retrieves available PHP versions for a given server and PHP handler type.
-// The phpType parameter should be "php-fpm", "fast-cgi", or "hhvm".
-// Returns a map of short PHP version string -> full info string
-// (e.g. "8.4" -> "PHP 8.4:/etc/init.d/php8.4-fpm:/etc/php/8.4/fpm:/etc/php/8.4/fpm/pool.d").
-func (c *Client) GetPHPVersions(ctx context.Context, serverID int, phpType string) (map[string]string, error) {
+// AddWebFolderUser creates a new web folder user
+func (c *Client) AddWebFolderUser(ctx context.Context, webFolderUser *WebFolderUser, clientID int) (int, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
-		"server_id":  serverID,
-		"php":        phpType,
+		"client_id":  clientID,
+		"params":     webFolderUser,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "server_get_php_versions", params, &response)
+	err := c.makeRequest(ctx, "sites_web_folder_user_add", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get PHP versions: %w", err)
+		return 0, fmt.Errorf("failed to add web folder user: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get PHP versions: %s", response.Message)
+		return 0, fmt.Errorf("failed to add web folder user: %s", response.Message)
 	}
 
-	// The API returns a JSON array of PHP info strings, e.g.:
-	//   ["PHP 7.0:/etc/init.d/php7.0-fpm:...", "PHP 8.4:..."]
-	var phpVersionsList []string
-	if err := unmarshalResponse(response.Response, &phpVersionsList); err != nil {
-		return nil, fmt.Errorf("failed to parse PHP versions response: %w", err)
+	return parseResponseID(response.Response)
+}
+
+// GetWebFolderUser retrieves a web folder user by ID
+func (c *Client) GetWebFolderUser(ctx context.Context, webFolderUserID int) (*WebFolderUser, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": webFolderUserID,
 	}
 
-	result := make(map[string]string, len(phpVersionsList))
-	for _, info := range phpVersionsList {
-		version := ParsePHPVersion(info)
-		if version == "" {
-			return nil, fmt.Errorf("failed to extract PHP version from %q", info)
-		}
-		result[version] = info
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_web_folder_user_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get web folder user: %w", err)
 	}
 
-	return result, nil
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get web folder user: %s", response.Message)
+	}
+
+	var webFolderUser WebFolderUser
+	if err := unmarshalResponse(response.Response, &webFolderUser); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal web folder user: %w", err)
+	}
+
+	return &webFolderUser, nil
 }
 
-// ParsePHPVersion extracts the version number from a PHP info string.
-// Input format: "PHP 8.4:/etc/init.d/php8.4-fpm:/etc/php/8.4/fpm:/etc/php/8.4/fpm/pool.d"
-// Returns: "8.4"
-func ParsePHPVersion(info string) string {
-	// Split by ":" to get the name part, e.g. "PHP 8.4"
-	parts := strings.SplitN(info, ":", 2)
-	if len(parts) == 0 {
-		return ""
+// UpdateWebFolderUser updates a web folder user
+func (c *Client) UpdateWebFolderUser(ctx context.Context, webFolderUserID int, clientID int, webFolderUser *WebFolderUser) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"primary_id": webFolderUserID,
+		"params":     webFolderUser,
 	}
-	name := strings.TrimSpace(parts[0])
 
-	// Remove "PHP " prefix (case-insensitive)
-	if len(name) > 4 && strings.EqualFold(name[:4], "PHP ") {
-		return strings.TrimSpace(name[4:])
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_web_folder_user_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update web folder user: %w", err)
 	}
 
-	return ""
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update web folder user: %s", response.Message)
+	}
+
+	return nil
 }
 
-// Client methods
+// DeleteWebFolderUser deletes a web folder user
+func (c *Client) DeleteWebFolderUser(ctx context.Context, webFolderUserID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": webFolderUserID,
+	}
 
-// GetClient retrieves a client by ID
-func (c *Client) GetClient(ctx context.Context, clientID int) (*ISPConfigClient, error) {
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_web_folder_user_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete web folder user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete web folder user: %s", response.Message)
+	}
+
+	return nil
+}
+
+// Backup methods
+
+// GetWebBackups lists the backups of a website, including the backups of its
+// databases
+func (c *Client) GetWebBackups(ctx context.Context, siteID int) ([]WebBackup, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"site_id":    siteID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_web_domain_backup_list", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to list backups: %s", response.Message)
+	}
+
+	var backups []WebBackup
+	if err := unmarshalRecords(response.Response, &backups); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backups: %w", err)
+	}
+
+	return backups, nil
+}
+
+// RestoreWebBackup queues the restore of a backup. ISPConfig runs the restore
+// asynchronously on the backup's server.
+func (c *Client) RestoreWebBackup(ctx context.Context, backupID int) error {
+	params := map[string]interface{}{
+		"session_id":  c.getSessionID(),
+		"primary_id":  backupID,
+		"action_type": "backup_restore",
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_web_domain_backup", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to restore backup: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to restore backup: %s", response.Message)
+	}
+
+	return nil
+}
+
+// Usage methods
+
+// GetWebQuotas returns the disk usage of all websites of a client
+func (c *Client) GetWebQuotas(ctx context.Context, clientID int) ([]WebQuota, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
 		"client_id":  clientID,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "client_get", params, &response)
+	err := c.makeRequest(ctx, "quota_get_by_user", params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get client: %w", err)
+		return nil, fmt.Errorf("failed to get disk usage: %w", err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get client: %s", response.Message)
+		return nil, fmt.Errorf("failed to get disk usage: %s", response.Message)
 	}
 
-	var ispClient ISPConfigClient
-	if err := unmarshalResponse(response.Response, &ispClient); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal client: %w", err)
+	var quotas []WebQuota
+	if err := unmarshalRecords(response.Response, &quotas); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal disk usage: %w", err)
 	}
 
-	return &ispClient, nil
+	return quotas, nil
 }
 
-// GetAllClients retrieves all clients
-func (c *Client) GetAllClients(ctx context.Context) ([]ISPConfigClient, error) {
+// GetWebTraffic returns the HTTP traffic of all websites of a client
+func (c *Client) GetWebTraffic(ctx context.Context, clientID int) ([]WebTraffic, error) {
+	return c.getTraffic(ctx, "trafficquota_get_by_user", "traffic", clientID)
+}
+
+// GetFTPTraffic returns the FTP traffic of all websites of a client
+func (c *Client) GetFTPTraffic(ctx context.Context, clientID int) ([]WebTraffic, error) {
+	return c.getTraffic(ctx, "ftptrafficquota_data", "FTP traffic", clientID)
+}
+
+// getTraffic calls one of the traffic quota methods, which share their
+// signature and result format
+func (c *Client) getTraffic(ctx context.Context, method, what string, clientID int) ([]WebTraffic, error) {
 	params := map[string]interface{}{
 		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"lastdays":   0,
 	}
 
 	var response APIResponse
-	err := c.makeRequest(ctx, "client_get_all", params, &response)
+	err := c.makeRequest(ctx, method, params, &response)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get all clients: %w", err)
+		return nil, fmt.Errorf("failed to get %s: %w", what, err)
 	}
 
 	if response.Code != "ok" {
-		return nil, fmt.Errorf("failed to get all clients: %s", response.Message)
+		return nil, fmt.Errorf("failed to get %s: %s", what, response.Message)
 	}
 
-	var clients []ISPConfigClient
-	if err := unmarshalResponse(response.Response, &clients); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal clients: %w", err)
+	var traffic []WebTraffic
+	if err := unmarshalRecords(response.Response, &traffic); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", what, err)
 	}
 
-	return clients, nil
+	return traffic, nil
+}
+
+// Database methods
+
+// AddDatabase creates a new database
+func (c *Client) AddDatabase(ctx context.Context, database *Database, clientID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"params":     database,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add database: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add database: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// GetDatabase retrieves a database by ID
+func (c *Client) GetDatabase(ctx context.Context, databaseID int) (*Database, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": databaseID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get database: %s", response.Message)
+	}
+
+	var database Database
+	if err := unmarshalResponse(response.Response, &database); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal database: %w", err)
+	}
+
+	return &database, nil
+}
+
+// UpdateDatabase updates a database
+func (c *Client) UpdateDatabase(ctx context.Context, databaseID int, clientID int, database *Database) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"primary_id": databaseID,
+		"params":     database,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update database: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update database: %s", response.Message)
+	}
+
+	return nil
+}
+
+// DeleteDatabase deletes a database
+func (c *Client) DeleteDatabase(ctx context.Context, databaseID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": databaseID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete database: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete database: %s", response.Message)
+	}
+
+	return nil
+}
+
+// Database User methods
+
+// AddDatabaseUser creates a new database user
+func (c *Client) AddDatabaseUser(ctx context.Context, dbUser *DatabaseUser, clientID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"params":     dbUser,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_user_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add database user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add database user: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// GetDatabaseUser retrieves a database user by ID
+func (c *Client) GetDatabaseUser(ctx context.Context, dbUserID int) (*DatabaseUser, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": dbUserID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_user_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get database user: %s", response.Message)
+	}
+
+	var dbUser DatabaseUser
+	if err := unmarshalResponse(response.Response, &dbUser); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal database user: %w", err)
+	}
+
+	return &dbUser, nil
+}
+
+// UpdateDatabaseUser updates a database user
+func (c *Client) UpdateDatabaseUser(ctx context.Context, dbUserID int, clientID int, dbUser *DatabaseUser) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"primary_id": dbUserID,
+		"params":     dbUser,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_user_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update database user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update database user: %s", response.Message)
+	}
+
+	return nil
+}
+
+// DeleteDatabaseUser deletes a database user
+func (c *Client) DeleteDatabaseUser(ctx context.Context, dbUserID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": dbUserID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_database_user_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete database user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete database user: %s", response.Message)
+	}
+
+	return nil
+}
+
+// Cron Job methods
+
+// AddCronJob creates a new cron task
+func (c *Client) AddCronJob(ctx context.Context, cronJob *CronJob, clientID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"params":     cronJob,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_cron_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add cron job: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add cron job: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// GetCronJob retrieves a cron job by ID
+func (c *Client) GetCronJob(ctx context.Context, cronJobID int) (*CronJob, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"cron_id":    cronJobID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_cron_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron job: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get cron job: %s", response.Message)
+	}
+
+	jsonData, err := json.Marshal(response.Response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	// ISPConfig may return an array ([{...}] or []) instead of a plain object
+	if len(jsonData) > 0 && jsonData[0] == '[' {
+		var arr []json.RawMessage
+		if err := json.Unmarshal(jsonData, &arr); err != nil || len(arr) == 0 {
+			return nil, fmt.Errorf("cron job not found (id: %d)", cronJobID)
+		}
+		jsonData = arr[0]
+	}
+
+	var cronJob CronJob
+	err = json.Unmarshal(jsonData, &cronJob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cron job: %w", err)
+	}
+
+	return &cronJob, nil
+}
+
+// UpdateCronJob updates a cron job
+func (c *Client) UpdateCronJob(ctx context.Context, cronJobID int, clientID int, cronJob *CronJob) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"cron_id":    cronJobID,
+		"params":     cronJob,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_cron_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update cron job: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update cron job: %s", response.Message)
+	}
+
+	return nil
+}
+
+// DeleteCronJob deletes a cron job
+func (c *Client) DeleteCronJob(ctx context.Context, cronJobID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"cron_id":    cronJobID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "sites_cron_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete cron job: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete cron job: %s", response.Message)
+	}
+
+	return nil
+}
+
+// Mail Domain methods
+
+// AddMailDomain creates a new mail domain
+func (c *Client) AddMailDomain(ctx context.Context, mailDomain *MailDomain, clientID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"params":     mailDomain,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_domain_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add mail domain: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add mail domain: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// GetMailDomain retrieves a mail domain by ID
+func (c *Client) GetMailDomain(ctx context.Context, mailDomainID int) (*MailDomain, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": mailDomainID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_domain_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mail domain: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get mail domain: %s", response.Message)
+	}
+
+	var mailDomain MailDomain
+	if err := unmarshalResponse(response.Response, &mailDomain); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal mail domain: %w", err)
+	}
+
+	return &mailDomain, nil
+}
+
+// UpdateMailDomain updates a mail domain
+func (c *Client) UpdateMailDomain(ctx context.Context, mailDomainID int, clientID int, mailDomain *MailDomain) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"primary_id": mailDomainID,
+		"params":     mailDomain,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_domain_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update mail domain: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update mail domain: %s", response.Message)
+	}
+
+	return nil
+}
+
+// DeleteMailDomain deletes a mail domain
+func (c *Client) DeleteMailDomain(ctx context.Context, mailDomainID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": mailDomainID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_domain_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete mail domain: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete mail domain: %s", response.Message)
+	}
+
+	return nil
+}
+
+// Mail User methods
+
+// AddMailUser creates a new mail user (mailbox)
+func (c *Client) AddMailUser(ctx context.Context, mailUser *MailUser, clientID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"params":     mailUser,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_user_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add mail user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add mail user: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// GetMailUser retrieves a mail user by ID
+func (c *Client) GetMailUser(ctx context.Context, mailUserID int) (*MailUser, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": mailUserID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_user_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mail user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get mail user: %s", response.Message)
+	}
+
+	var mailUser MailUser
+	if err := unmarshalResponse(response.Response, &mailUser); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal mail user: %w", err)
+	}
+
+	return &mailUser, nil
+}
+
+// UpdateMailUser updates a mail user (mailbox)
+func (c *Client) UpdateMailUser(ctx context.Context, mailUserID int, clientID int, mailUser *MailUser) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"primary_id": mailUserID,
+		"params":     mailUser,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_user_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update mail user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update mail user: %s", response.Message)
+	}
+
+	return nil
+}
+
+// DeleteMailUser deletes a mail user (mailbox)
+func (c *Client) DeleteMailUser(ctx context.Context, mailUserID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": mailUserID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "mail_user_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete mail user: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete mail user: %s", response.Message)
+	}
+
+	return nil
+}
+
+// Server methods
+
+// GetPHPVersions retrieves available PHP versions for a given server and PHP handler type.
+// The phpType parameter should be "php-fpm", "fast-cgi", or "hhvm".
+// Returns a map of short PHP version string -> full info string
+// (e.g. "8.4" -> "PHP 8.4:/etc/init.d/php8.4-fpm:/etc/php/8.4/fpm:/etc/php/8.4/fpm/pool.d").
+func (c *Client) GetPHPVersions(ctx context.Context, serverID int, phpType string) (map[string]string, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"server_id":  serverID,
+		"php":        phpType,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_get_php_versions", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PHP versions: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get PHP versions: %s", response.Message)
+	}
+
+	// The API returns a JSON array of PHP info strings, e.g.:
+	//   ["PHP 7.0:/etc/init.d/php7.0-fpm:...", "PHP 8.4:..."]
+	var phpVersionsList []string
+	if err := unmarshalResponse(response.Response, &phpVersionsList); err != nil {
+		return nil, fmt.Errorf("failed to parse PHP versions response: %w", err)
+	}
+
+	result := make(map[string]string, len(phpVersionsList))
+	for _, info := range phpVersionsList {
+		version := ParsePHPVersion(info)
+		if version == "" {
+			return nil, fmt.Errorf("failed to extract PHP version from %q", info)
+		}
+		result[version] = info
+	}
+
+	return result, nil
+}
+
+// GetServers retrieves all servers with their role flags. server_get_all
+// only returns IDs and names, so the functions of each server are fetched
+// separately.
+func (c *Client) GetServers(ctx context.Context) ([]Server, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_get_all", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get servers: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get servers: %s", response.Message)
+	}
+
+	var servers []Server
+	if err := unmarshalRecords(response.Response, &servers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal servers: %w", err)
+	}
+
+	for i := range servers {
+		if err := c.getServerFunctions(ctx, &servers[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return servers, nil
+}
+
+// getServerFunctions fills the role flags and mirror of a server
+func (c *Client) getServerFunctions(ctx context.Context, server *Server) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"server_id":  int(server.ID),
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_get_functions", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to get server functions: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to get server functions: %s", response.Message)
+	}
+
+	// The functions are returned as a one-element list of records
+	result := response.Response
+	if record, ok := result.(map[string]interface{}); ok {
+		if _, single := record["web_server"]; single {
+			result = []interface{}{record}
+		}
+	}
+	var functions []Server
+	if err := unmarshalRecords(result, &functions); err != nil {
+		return fmt.Errorf("failed to unmarshal server functions: %w", err)
+	}
+	if len(functions) == 0 {
+		return fmt.Errorf("failed to get server functions: server ID %d not found", server.ID)
+	}
+
+	id, name := server.ID, server.Name
+	*server = functions[0]
+	server.ID, server.Name = id, name
+	return nil
+}
+
+// GetServerConfig retrieves one section (e.g. "server", "web", "mail") of a
+// server's configuration. ISPConfig stores it as an ini file, so all values
+// are returned as strings.
+func (c *Client) GetServerConfig(ctx context.Context, serverID int, section string) (map[string]string, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"server_id":  serverID,
+		"section":    section,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server config: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get server config: %s", response.Message)
+	}
+
+	raw, ok := response.Response.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to get server config: no section %q for server ID %d", section, serverID)
+	}
+
+	config := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if value == nil {
+			config[key] = ""
+			continue
+		}
+		config[key] = fmt.Sprint(value)
+	}
+
+	return config, nil
+}
+
+// GetServerIPs retrieves the IP addresses configured on a server, or on all
+// servers if serverID is 0
+func (c *Client) GetServerIPs(ctx context.Context, serverID int) ([]ServerIP, error) {
+	var primaryID interface{} = -1
+	if serverID != 0 {
+		primaryID = map[string]interface{}{"server_id": serverID}
+	}
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": primaryID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_ip_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server IPs: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get server IPs: %s", response.Message)
+	}
+
+	var ips []ServerIP
+	if err := unmarshalRecords(response.Response, &ips); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal server IPs: %w", err)
+	}
+
+	return ips, nil
+}
+
+// AddServerIP adds an IP address to a server
+func (c *Client) AddServerIP(ctx context.Context, ip *ServerIP, clientID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"params":     ip,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_ip_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add server IP: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add server IP: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// GetServerIP retrieves a server IP address by ID
+func (c *Client) GetServerIP(ctx context.Context, ipID int) (*ServerIP, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": ipID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_ip_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server IP: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get server IP: %s", response.Message)
+	}
+
+	var ip ServerIP
+	if err := unmarshalResponse(response.Response, &ip); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal server IP: %w", err)
+	}
+	if ip.ID == 0 {
+		return nil, fmt.Errorf("server IP not found (id: %d)", ipID)
+	}
+
+	return &ip, nil
+}
+
+// UpdateServerIP updates a server IP address
+func (c *Client) UpdateServerIP(ctx context.Context, ipID int, clientID int, ip *ServerIP) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+		"ip_id":      ipID,
+		"params":     ip,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_ip_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update server IP: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update server IP: %s", response.Message)
+	}
+
+	return nil
+}
+
+// DeleteServerIP deletes a server IP address
+func (c *Client) DeleteServerIP(ctx context.Context, ipID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"ip_id":      ipID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "server_ip_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete server IP: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete server IP: %s", response.Message)
+	}
+
+	return nil
+}
+
+// ParsePHPVersion extracts the version number from a PHP info string.
+// Input format: "PHP 8.4:/etc/init.d/php8.4-fpm:/etc/php/8.4/fpm:/etc/php/8.4/fpm/pool.d"
+// Returns: "8.4"
+func ParsePHPVersion(info string) string {
+	// Split by ":" to get the name part, e.g. "PHP 8.4"
+	parts := strings.SplitN(info, ":", 2)
+	if len(parts) == 0 {
+		return ""
+	}
+	name := strings.TrimSpace(parts[0])
+
+	// Remove "PHP " prefix (case-insensitive)
+	if len(name) > 4 && strings.EqualFold(name[:4], "PHP ") {
+		return strings.TrimSpace(name[4:])
+	}
+
+	return ""
+}
+
+// Client methods
+
+// GetClient retrieves a client by ID
+func (c *Client) GetClient(ctx context.Context, clientID int) (*ISPConfigClient, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get client: %s", response.Message)
+	}
+
+	var ispClient ISPConfigClient
+	if err := unmarshalResponse(response.Response, &ispClient); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client: %w", err)
+	}
+
+	return &ispClient, nil
+}
+
+// GetClientByUsername retrieves a client by its panel login name
+func (c *Client) GetClientByUsername(ctx context.Context, username string) (*ISPConfigClient, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"username":   username,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_get_by_username", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client by username: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get client by username: %s", response.Message)
+	}
+
+	// client_get_by_username returns the panel user (sys_user), or false if
+	// there is none; the client record is fetched by its client_id
+	if _, ok := response.Response.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("client not found (username: %q)", username)
+	}
+	var sysUser ISPConfigClient
+	if err := unmarshalResponse(response.Response, &sysUser); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client user: %w", err)
+	}
+	if sysUser.ID == 0 {
+		return nil, fmt.Errorf("client not found (username: %q)", username)
+	}
+
+	return c.GetClient(ctx, int(sysUser.ID))
+}
+
+// GetClientByCustomerNo retrieves a client by its customer number. The
+// client_get_by_customer_no method requires ISPConfig 3.1 or later.
+func (c *Client) GetClientByCustomerNo(ctx context.Context, customerNo string) (*ISPConfigClient, error) {
+	params := map[string]interface{}{
+		"session_id":  c.getSessionID(),
+		"customer_no": customerNo,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_get_by_customer_no", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client by customer number: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get client by customer number: %s", response.Message)
+	}
+
+	if _, ok := response.Response.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("client not found (customer_no: %q)", customerNo)
+	}
+	var ispClient ISPConfigClient
+	if err := unmarshalResponse(response.Response, &ispClient); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client: %w", err)
+	}
+	if ispClient.ID == 0 {
+		return nil, fmt.Errorf("client not found (customer_no: %q)", customerNo)
+	}
+
+	return &ispClient, nil
+}
+
+// GetAllClients retrieves all clients visible to the remote user
+func (c *Client) GetAllClients(ctx context.Context) ([]ISPConfigClient, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_get_all", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all clients: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get all clients: %s", response.Message)
+	}
+
+	// client_get_all only returns the client IDs, so each record is fetched
+	// separately
+	var ids []FlexInt
+	if err := unmarshalRecords(response.Response, &ids); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client IDs: %w", err)
+	}
+
+	clients := make([]ISPConfigClient, 0, len(ids))
+	for _, id := range ids {
+		ispClient, err := c.GetClient(ctx, int(id))
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, *ispClient)
+	}
+
+	return clients, nil
+}
+
+// AddClient creates a new client below the given reseller (0 for none)
+func (c *Client) AddClient(ctx context.Context, ispClient *ISPConfigClient, resellerID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id":  c.getSessionID(),
+		"reseller_id": resellerID,
+		"params":      ispClient,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add client: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add client: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// UpdateClient updates an existing client. ISPConfig merges the params into
+// the stored record, so omitted fields keep their value.
+func (c *Client) UpdateClient(ctx context.Context, clientID, resellerID int, ispClient *ISPConfigClient) error {
+	params := map[string]interface{}{
+		"session_id":  c.getSessionID(),
+		"client_id":   clientID,
+		"reseller_id": resellerID,
+		"params":      ispClient,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_update", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to update client: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to update client: %s", response.Message)
+	}
+
+	return nil
+}
+
+// DeleteClient deletes a client
+func (c *Client) DeleteClient(ctx context.Context, clientID int) error {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete client: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete client: %s", response.Message)
+	}
+
+	return nil
+}
+
+// GetClientIDByUser retrieves the client of a panel user, e.g. the
+// sys_userid that owns a record. The admin user has client ID 0.
+func (c *Client) GetClientIDByUser(ctx context.Context, sysUserID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"sys_userid": sysUserID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_get_id", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get client ID: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to get client ID: %s", response.Message)
+	}
+
+	clientID, err := parseResponseID(response.Response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse client ID: %w", err)
+	}
+
+	return clientID, nil
+}
+
+// GetClientGroupID retrieves the system group of a client, which owns the
+// client's records
+func (c *Client) GetClientGroupID(ctx context.Context, clientID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_get_groupid", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get client group ID: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to get client group ID: %s", response.Message)
+	}
+
+	groupID, err := parseResponseID(response.Response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse client group ID: %w", err)
+	}
+
+	return groupID, nil
+}
+
+// clientLimitRecords maps the client limits to the *_get method and filter
+// of the records ISPConfig counts against them
+var clientLimitRecords = map[string]struct {
+	method string
+	filter map[string]interface{}
+}{
+	"limit_web_domain": {method: "sites_web_domain_get", filter: map[string]interface{}{"type": "vhost"}},
+	"limit_mailbox":    {method: "mail_user_get"},
+	"limit_database":   {method: "sites_database_get"}, // MySQL and PostgreSQL databases
+}
+
+// CountClientRecords counts the records of a client that ISPConfig compares
+// with the given limit (limit_web_domain, limit_mailbox or limit_database)
+// when it enforces the client limits. Only the records of that limit are
+// fetched.
+func (c *Client) CountClientRecords(ctx context.Context, clientID int, limit string) (int, error) {
+	records, ok := clientLimitRecords[limit]
+	if !ok {
+		return 0, fmt.Errorf("unsupported client limit: %s", limit)
+	}
+
+	groupID, err := c.GetClientGroupID(ctx, clientID)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := map[string]interface{}{"sys_groupid": groupID}
+	for field, value := range records.filter {
+		filter[field] = value
+	}
+	return c.countRecords(ctx, records.method, filter)
+}
+
+// countRecords counts the records a *_get method returns for the given
+// field filter
+func (c *Client) countRecords(ctx context.Context, method string, filter map[string]interface{}) (int, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"primary_id": filter,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, method, params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count records (%s): %w", method, err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to count records (%s): %s", method, response.Message)
+	}
+
+	var records []json.RawMessage
+	if err := unmarshalRecords(response.Response, &records); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal records (%s): %w", method, err)
+	}
+
+	return len(records), nil
+}
+
+// GetClientTemplates retrieves all client templates
+func (c *Client) GetClientTemplates(ctx context.Context) ([]ClientTemplate, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_templates_get_all", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client templates: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get client templates: %s", response.Message)
+	}
+
+	var records []map[string]interface{}
+	if err := unmarshalRecords(response.Response, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client templates: %w", err)
+	}
+
+	templates := make([]ClientTemplate, 0, len(records))
+	for _, record := range records {
+		var template ClientTemplate
+		if err := unmarshalResponse(record, &template); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal client template: %w", err)
+		}
+		// Only numeric limits; flags such as limit_ssl are "y"/"n"
+		template.Limits = map[string]int{}
+		for key, value := range record {
+			if !strings.HasPrefix(key, "limit_") {
+				continue
+			}
+			if n, err := strconv.Atoi(fmt.Sprint(value)); err == nil {
+				template.Limits[key] = n
+			}
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// GetClientAdditionalTemplates retrieves the additional templates assigned to
+// a client
+func (c *Client) GetClientAdditionalTemplates(ctx context.Context, clientID int) ([]ClientTemplateAssignment, error) {
+	params := map[string]interface{}{
+		"session_id": c.getSessionID(),
+		"client_id":  clientID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_template_additional_get", params, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get additional client templates: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return nil, fmt.Errorf("failed to get additional client templates: %s", response.Message)
+	}
+
+	var assignments []ClientTemplateAssignment
+	if err := unmarshalRecords(response.Response, &assignments); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal additional client templates: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// AddClientAdditionalTemplate assigns an additional template to a client and
+// returns the ID of the assignment
+func (c *Client) AddClientAdditionalTemplate(ctx context.Context, clientID, templateID int) (int, error) {
+	params := map[string]interface{}{
+		"session_id":  c.getSessionID(),
+		"client_id":   clientID,
+		"template_id": templateID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_template_additional_add", params, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add additional client template: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return 0, fmt.Errorf("failed to add additional client template: %s", response.Message)
+	}
+
+	return parseResponseID(response.Response)
+}
+
+// DeleteClientAdditionalTemplate removes an additional template assignment
+// from a client
+func (c *Client) DeleteClientAdditionalTemplate(ctx context.Context, clientID, assignmentID int) error {
+	params := map[string]interface{}{
+		"session_id":           c.getSessionID(),
+		"client_id":            clientID,
+		"assigned_template_id": assignmentID,
+	}
+
+	var response APIResponse
+	err := c.makeRequest(ctx, "client_template_additional_delete", params, &response)
+	if err != nil {
+		return fmt.Errorf("failed to delete additional client template: %w", err)
+	}
+
+	if response.Code != "ok" {
+		return fmt.Errorf("failed to delete additional client template: %s", response.Message)
+	}
+
+	return nil
 }
@@ -2,11 +2,20 @@ package client
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 // newTestClient creates a Client pointing at the given test server.
@@ -174,6 +183,281 @@ func TestDeleteWebDomain(t *testing.T) {
 	}
 }
 
+func TestAddWebVhostSubdomain(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"sites_web_vhost_subdomain_add": func(params map[string]interface{}) interface{} {
+			domain, _ := params["params"].(map[string]interface{})
+			if domain["type"] != "vhostsubdomain" || domain["web_folder"] != "staging" {
+				return false
+			}
+			return float64(43)
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	id, err := c.AddWebVhostSubdomain(ctx, &WebDomain{
+		Domain:         "staging.example.com",
+		Type:           "vhostsubdomain",
+		ParentDomainID: 42,
+		WebFolder:      "staging",
+	}, 1)
+	if err != nil {
+		t.Fatalf("AddWebVhostSubdomain() error: %v", err)
+	}
+	if id != 43 {
+		t.Errorf("got ID %d, want 43", id)
+	}
+}
+
+func TestGetServerConfig(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"server_get": func(params map[string]interface{}) interface{} {
+			if params["section"] != "web" || params["server_id"] != float64(1) {
+				return false
+			}
+			return map[string]interface{}{"server_type": "nginx", "php_ini_check_minutes": float64(1), "php_handler": nil}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	config, err := c.GetServerConfig(ctx, 1, "web")
+	if err != nil {
+		t.Fatalf("GetServerConfig() error: %v", err)
+	}
+	want := map[string]string{"server_type": "nginx", "php_ini_check_minutes": "1", "php_handler": ""}
+	for k, v := range want {
+		if config[k] != v {
+			t.Errorf("config[%q] = %q, want %q", k, config[k], v)
+		}
+	}
+
+	if _, err := c.GetServerConfig(ctx, 2, "web"); err == nil {
+		t.Error("GetServerConfig() expected error for unknown server, got nil")
+	}
+}
+
+func TestGetServers(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"server_get_all": func(params map[string]interface{}) interface{} {
+			return []interface{}{
+				map[string]interface{}{"server_id": "1", "server_name": "web1.example.com"},
+				map[string]interface{}{"server_id": "2", "server_name": "web2.example.com"},
+			}
+		},
+		"server_get_functions": func(params map[string]interface{}) interface{} {
+			mirror := "0"
+			if params["server_id"] == float64(2) {
+				mirror = "1"
+			}
+			return []interface{}{map[string]interface{}{
+				"web_server": "1", "mail_server": "0", "db_server": "1", "dns_server": "0", "file_server": "1",
+				"vserver_server": "0", "proxy_server": "0", "firewall_server": "0", "mirror_server_id": mirror,
+			}}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	servers, err := c.GetServers(context.Background())
+	if err != nil {
+		t.Fatalf("GetServers() error: %v", err)
+	}
+	if len(servers) != 2 {
+		t.Fatalf("got %d servers, want 2", len(servers))
+	}
+	if servers[0].ID != 1 || servers[0].Name != "web1.example.com" || servers[0].WebServer != 1 || servers[0].MailServer != 0 {
+		t.Errorf("unexpected server: %+v", servers[0])
+	}
+	if servers[1].ID != 2 || servers[1].MirrorServerID != 1 {
+		t.Errorf("unexpected mirror server: %+v", servers[1])
+	}
+}
+
+func TestGetServerIPs(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"server_ip_get": func(params map[string]interface{}) interface{} {
+			filter, ok := params["primary_id"].(map[string]interface{})
+			if !ok || filter["server_id"] != float64(1) {
+				return false
+			}
+			return []interface{}{
+				map[string]interface{}{
+					"server_ip_id": "3", "server_id": "1", "client_id": "0", "ip_type": "IPv4",
+					"ip_address": "192.0.2.10", "virtualhost": "y", "virtualhost_port": "80,443",
+				},
+				map[string]interface{}{
+					"server_ip_id": "4", "server_id": "1", "client_id": "5", "ip_type": "IPv6",
+					"ip_address": "2001:db8::10", "virtualhost": "n", "virtualhost_port": "80,443",
+				},
+			}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	ips, err := c.GetServerIPs(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetServerIPs() error: %v", err)
+	}
+	if len(ips) != 2 {
+		t.Fatalf("got %d IPs, want 2", len(ips))
+	}
+	if ips[0].ID != 3 || ips[0].IPAddress != "192.0.2.10" || ips[0].VirtualHost != "y" {
+		t.Errorf("unexpected IP: %+v", ips[0])
+	}
+	if ips[1].ClientID != 5 || ips[1].IPType != "IPv6" {
+		t.Errorf("unexpected IP: %+v", ips[1])
+	}
+
+	ips, err = c.GetServerIPs(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetServerIPs() error: %v", err)
+	}
+	if len(ips) != 0 {
+		t.Errorf("got %d IPs for a server without IPs, want 0", len(ips))
+	}
+}
+
+func TestGetWebBackups(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"sites_web_domain_backup_list": func(params map[string]interface{}) interface{} {
+			if params["site_id"] == float64(2) {
+				return false // no backups
+			}
+			if params["site_id"] == float64(3) {
+				// PHP encodes arrays with non-sequential keys as objects
+				return map[string]interface{}{
+					"1": map[string]interface{}{"backup_id": "8", "parent_domain_id": "3", "backup_type": "web"},
+					"0": map[string]interface{}{"backup_id": "7", "parent_domain_id": "3", "backup_type": "web"},
+				}
+			}
+			return []interface{}{
+				map[string]interface{}{
+					"backup_id":        "5",
+					"server_id":        "1",
+					"parent_domain_id": "1",
+					"backup_type":      "mysql",
+					"tstamp":           "1767225600",
+					"filename":         "db_c1app_2026-01-01_00-00.sql.gz",
+					"filesize":         "1048576",
+				},
+			}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	backups, err := c.GetWebBackups(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetWebBackups() error: %v", err)
+	}
+	if len(backups) != 1 || backups[0].ID != 5 || backups[0].Filesize != 1048576 || backups[0].BackupType != "mysql" {
+		t.Errorf("unexpected backups: %+v", backups)
+	}
+
+	backups, err = c.GetWebBackups(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetWebBackups() error: %v", err)
+	}
+	if len(backups) != 0 {
+		t.Errorf("got %d backups, want 0", len(backups))
+	}
+
+	backups, err = c.GetWebBackups(ctx, 3)
+	if err != nil {
+		t.Fatalf("GetWebBackups() error: %v", err)
+	}
+	if len(backups) != 2 || backups[0].ID != 7 || backups[1].ID != 8 {
+		t.Errorf("unexpected backups: %+v", backups)
+	}
+}
+
+func TestGetWebUsage(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"quota_get_by_user": func(params map[string]interface{}) interface{} {
+			return []interface{}{
+				map[string]interface{}{
+					"domain_id": "1",
+					"domain":    "example.com",
+					"hd_quota":  "1000",
+					"used":      "512000",
+					"soft":      "1024000",
+					"hard":      "1024000",
+				},
+			}
+		},
+		"trafficquota_get_by_user": func(params map[string]interface{}) interface{} {
+			// Non-sequential PHP arrays are encoded as objects
+			return map[string]interface{}{
+				"3": map[string]interface{}{"domain_id": "3", "domain": "b.example.com", "traffic_quota": "-1", "this_month": nil},
+				"1": map[string]interface{}{"domain_id": "1", "domain": "example.com", "traffic_quota": "5000", "this_month": "1048576"},
+			}
+		},
+		"ftptrafficquota_data": func(params map[string]interface{}) interface{} {
+			return false
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	quotas, err := c.GetWebQuotas(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetWebQuotas() error: %v", err)
+	}
+	if len(quotas) != 1 || quotas[0].Used != 512000 || quotas[0].HdQuota != 1000 {
+		t.Errorf("unexpected quotas: %+v", quotas)
+	}
+
+	traffic, err := c.GetWebTraffic(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetWebTraffic() error: %v", err)
+	}
+	if len(traffic) != 2 || traffic[0].DomainID != 1 || traffic[0].ThisMonth != 1048576 || traffic[1].TrafficQuota != -1 {
+		t.Errorf("unexpected traffic: %+v", traffic)
+	}
+
+	ftpTraffic, err := c.GetFTPTraffic(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetFTPTraffic() error: %v", err)
+	}
+	if len(ftpTraffic) != 0 {
+		t.Errorf("got %d FTP traffic records, want 0", len(ftpTraffic))
+	}
+}
+
+func TestUpdateFTPUser_ClearsExpires(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"sites_ftp_user_update": func(params map[string]interface{}) interface{} {
+			sent, _ = params["params"].(map[string]interface{})
+			return 1
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	if err := c.UpdateFTPUser(context.Background(), 5, 1, &FTPUser{Username: "deploy"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expires, ok := sent["expires"]
+	if !ok || expires != "" {
+		t.Errorf("expires = %v (sent: %v), want an explicit empty value", expires, ok)
+	}
+}
+
 func TestAddDatabase(t *testing.T) {
 	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
 		"sites_database_add": func(params map[string]interface{}) interface{} {
@@ -194,6 +478,298 @@ func TestAddDatabase(t *testing.T) {
 	}
 }
 
+func TestAddClient(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"client_add": func(params map[string]interface{}) interface{} {
+			if params["reseller_id"] != float64(3) {
+				t.Errorf("reseller_id = %v, want 3", params["reseller_id"])
+			}
+			p := params["params"].(map[string]interface{})
+			// A limit of 0 means "none" and must not be dropped
+			if v, ok := p["limit_shell_user"]; !ok || v != float64(0) {
+				t.Errorf("limit_shell_user = %v (present: %v), want 0", v, ok)
+			}
+			// Unset limits are left to ISPConfig
+			if v, ok := p["limit_web_domain"]; ok {
+				t.Errorf("limit_web_domain = %v, want it omitted", v)
+			}
+			// An unset master template keeps the stored one
+			if v, ok := p["template_master"]; ok {
+				t.Errorf("template_master = %v, want it omitted", v)
+			}
+			return 12
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	none := FlexInt(0)
+	id, err := c.AddClient(context.Background(), &ISPConfigClient{ContactName: "Jane Doe", Username: "jane", LimitShellUser: &none}, 3)
+	if err != nil {
+		t.Fatalf("AddClient() error: %v", err)
+	}
+	if id != 12 {
+		t.Errorf("got ID %d, want 12", id)
+	}
+}
+
+func TestGetAllClients(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"client_get_all": func(params map[string]interface{}) interface{} {
+			return []interface{}{"1", "4"}
+		},
+		"client_get": func(params map[string]interface{}) interface{} {
+			id := params["client_id"].(float64)
+			return map[string]interface{}{
+				"client_id":        id,
+				"parent_client_id": "1",
+				"username":         fmt.Sprintf("client%v", id),
+			}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	clients, err := c.GetAllClients(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllClients() error: %v", err)
+	}
+	if len(clients) != 2 || clients[0].ID != 1 || clients[1].ID != 4 {
+		t.Fatalf("unexpected clients: %+v", clients)
+	}
+	if clients[1].Username != "client4" || clients[1].ParentClientID != 1 {
+		t.Errorf("unexpected client record: %+v", clients[1])
+	}
+}
+
+func TestGetClientByUsername(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"client_get_by_username": func(params map[string]interface{}) interface{} {
+			if params["username"] != "jane" {
+				return false
+			}
+			return map[string]interface{}{"userid": "9", "client_id": "5", "username": "jane"}
+		},
+		"client_get": func(params map[string]interface{}) interface{} {
+			if params["client_id"] != float64(5) {
+				t.Errorf("client_id = %v, want 5", params["client_id"])
+			}
+			return map[string]interface{}{"client_id": "5", "username": "jane", "customer_no": "C1005"}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	ispClient, err := c.GetClientByUsername(context.Background(), "jane")
+	if err != nil {
+		t.Fatalf("GetClientByUsername() error: %v", err)
+	}
+	if ispClient.ID != 5 || ispClient.CustomerNo != "C1005" {
+		t.Errorf("unexpected client: %+v", ispClient)
+	}
+
+	if _, err := c.GetClientByUsername(context.Background(), "nobody"); err == nil {
+		t.Error("expected an error for an unknown username")
+	}
+}
+
+func TestGetClientIDByUser(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"client_get_id": func(params map[string]interface{}) interface{} {
+			if params["sys_userid"] != float64(9) {
+				t.Errorf("sys_userid = %v, want 9", params["sys_userid"])
+			}
+			return 5
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	clientID, err := c.GetClientIDByUser(context.Background(), 9)
+	if err != nil {
+		t.Fatalf("GetClientIDByUser() error: %v", err)
+	}
+	if clientID != 5 {
+		t.Errorf("got client ID %d, want 5", clientID)
+	}
+}
+
+func TestGetClientByCustomerNo(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"client_get_by_customer_no": func(params map[string]interface{}) interface{} {
+			if params["customer_no"] != "C1005" {
+				return false
+			}
+			return map[string]interface{}{"client_id": "5", "username": "jane", "customer_no": "C1005"}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	ispClient, err := c.GetClientByCustomerNo(context.Background(), "C1005")
+	if err != nil {
+		t.Fatalf("GetClientByCustomerNo() error: %v", err)
+	}
+	if ispClient.ID != 5 || ispClient.Username != "jane" {
+		t.Errorf("unexpected client: %+v", ispClient)
+	}
+
+	if _, err := c.GetClientByCustomerNo(context.Background(), "C9999"); err == nil {
+		t.Error("expected an error for an unknown customer number")
+	}
+}
+
+func TestCountClientRecords(t *testing.T) {
+	records := func(n int) interface{} {
+		list := []interface{}{}
+		for i := 0; i < n; i++ {
+			list = append(list, map[string]interface{}{"sys_groupid": "7"})
+		}
+		return list
+	}
+	var listed []string
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"client_get_groupid": func(params map[string]interface{}) interface{} {
+			return "7"
+		},
+		"sites_web_domain_get": func(params map[string]interface{}) interface{} {
+			listed = append(listed, "sites_web_domain_get")
+			filter := params["primary_id"].(map[string]interface{})
+			if filter["sys_groupid"] != float64(7) || filter["type"] != "vhost" {
+				t.Errorf("unexpected web domain filter: %v", filter)
+			}
+			return records(2)
+		},
+		"mail_user_get": func(params map[string]interface{}) interface{} {
+			listed = append(listed, "mail_user_get")
+			return false
+		},
+		"sites_database_get": func(params map[string]interface{}) interface{} {
+			listed = append(listed, "sites_database_get")
+			return records(3)
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	tests := []struct {
+		limit  string
+		method string
+		want   int
+	}{
+		{"limit_web_domain", "sites_web_domain_get", 2},
+		{"limit_mailbox", "mail_user_get", 0},
+		{"limit_database", "sites_database_get", 3},
+	}
+	for _, tt := range tests {
+		listed = nil
+		got, err := c.CountClientRecords(context.Background(), 5, tt.limit)
+		if err != nil {
+			t.Fatalf("CountClientRecords(%s) error: %v", tt.limit, err)
+		}
+		if got != tt.want {
+			t.Errorf("CountClientRecords(%s) = %d, want %d", tt.limit, got, tt.want)
+		}
+		if len(listed) != 1 || listed[0] != tt.method {
+			t.Errorf("CountClientRecords(%s) listed %v, want only %s", tt.limit, listed, tt.method)
+		}
+	}
+
+	if _, err := c.CountClientRecords(context.Background(), 5, "limit_cron"); err == nil {
+		t.Error("expected an error for an unsupported limit")
+	}
+}
+
+func TestGetClientTemplates(t *testing.T) {
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"client_templates_get_all": func(params map[string]interface{}) interface{} {
+			return []interface{}{
+				map[string]interface{}{
+					"template_id":      "2",
+					"template_name":    "Pro",
+					"template_type":    "m",
+					"limit_web_domain": "10",
+					"limit_mailquota":  "-1",
+					"limit_ssl":        "y",
+				},
+			}
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	templates, err := c.GetClientTemplates(context.Background())
+	if err != nil {
+		t.Fatalf("GetClientTemplates() error: %v", err)
+	}
+	if len(templates) != 1 || templates[0].ID != 2 || templates[0].Name != "Pro" || templates[0].Type != "m" {
+		t.Fatalf("unexpected templates: %+v", templates)
+	}
+	want := map[string]int{"limit_web_domain": 10, "limit_mailquota": -1}
+	if len(templates[0].Limits) != len(want) {
+		t.Errorf("got limits %v, want %v", templates[0].Limits, want)
+	}
+	for k, v := range want {
+		if templates[0].Limits[k] != v {
+			t.Errorf("limit %s = %d, want %d", k, templates[0].Limits[k], v)
+		}
+	}
+}
+
+func TestWaitForJobqueue(t *testing.T) {
+	pending, draining := 2, true
+	var serverID interface{}
+	server := httptest.NewServer(apiHandler(map[string]func(map[string]interface{}) interface{}{
+		"monitor_jobqueue_count": func(params map[string]interface{}) interface{} {
+			serverID = params["server_id"]
+			count := pending
+			if draining && pending > 0 {
+				pending--
+			}
+			return fmt.Sprint(count)
+		},
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	// Disabled by default: no request is made
+	if err := c.WaitForJobqueue(ctx, 3); err != nil {
+		t.Fatalf("WaitForJobqueue() error: %v", err)
+	}
+	if pending != 2 {
+		t.Fatalf("WaitForJobqueue() polled while disabled")
+	}
+
+	c.SetJobqueueWait(time.Second)
+	c.jobqueueInterval = time.Millisecond
+	if err := c.WaitForJobqueue(ctx, 3); err != nil {
+		t.Fatalf("WaitForJobqueue() error: %v", err)
+	}
+	if pending != 0 {
+		t.Errorf("WaitForJobqueue() returned with %d changes pending", pending)
+	}
+	if serverID != float64(3) {
+		t.Errorf("WaitForJobqueue() polled server_id %v, want 3", serverID)
+	}
+
+	pending, draining = 3, false
+	c.SetJobqueueWait(20 * time.Millisecond)
+	c.jobqueueInterval = time.Millisecond
+	err := c.WaitForJobqueue(ctx, 3)
+	if err == nil || !strings.Contains(err.Error(), "server 3") || !strings.Contains(err.Error(), "still pending") {
+		t.Errorf("WaitForJobqueue() error = %v, want a timeout", err)
+	}
+}
+
 func TestMakeRequest_ServerError(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -228,3 +804,174 @@ func TestMakeRequest_ContextCancellation(t *testing.T) {
 		t.Fatal("expected error for cancelled context, got nil")
 	}
 }
+
+func TestSetRequestTimeout(t *testing.T) {
+	if timeout := NewClient("example.com", "user", "pass", false).httpClient.Timeout; timeout != 30*time.Second {
+		t.Errorf("expected a default request timeout of 30s, got %s", timeout)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		_ = json.NewEncoder(w).Encode(APIResponse{Code: "ok"})
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server)
+
+	var resp APIResponse
+	if err := c.makeRequest(context.Background(), "test", map[string]interface{}{}, &resp); err != nil {
+		t.Fatalf("unexpected error without timeout: %v", err)
+	}
+
+	c.SetRequestTimeout(50 * time.Millisecond)
+	if err := c.makeRequest(context.Background(), "test", map[string]interface{}{}, &resp); err == nil {
+		t.Fatal("expected error for request exceeding the request timeout, got nil")
+	}
+
+	c.SetRequestTimeout(time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := c.makeRequest(ctx, "test", map[string]interface{}{}, &resp); err == nil {
+		t.Fatal("expected error for request exceeding the context deadline, got nil")
+	}
+}
+
+func TestSetCACertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(APIResponse{Code: "ok"})
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	var resp APIResponse
+	c := NewClient(host, "admin", "secret", false)
+	if err := c.makeRequest(context.Background(), "test", map[string]interface{}{}, &resp); err == nil {
+		t.Fatal("expected error for untrusted server certificate, got nil")
+	}
+
+	c = NewClient(host, "admin", "secret", false)
+	if err := c.SetCACertificates(caPEM); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.makeRequest(context.Background(), "test", map[string]interface{}{}, &resp); err != nil {
+		t.Fatalf("unexpected error with trusted CA: %v", err)
+	}
+
+	if err := c.SetCACertificates([]byte("not a certificate")); err == nil {
+		t.Fatal("expected error for invalid PEM, got nil")
+	}
+}
+
+func TestSetClientCertificate(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(APIResponse{Code: "ok"})
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	certPEM, keyPEM := testClientCertificate(t)
+
+	var resp APIResponse
+	c := NewClient(host, "admin", "secret", true)
+	if err := c.makeRequest(context.Background(), "test", map[string]interface{}{}, &resp); err == nil {
+		t.Fatal("expected error without client certificate, got nil")
+	}
+
+	c = NewClient(host, "admin", "secret", true)
+	if err := c.SetClientCertificate(certPEM, keyPEM); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.makeRequest(context.Background(), "test", map[string]interface{}{}, &resp); err != nil {
+		t.Fatalf("unexpected error with client certificate: %v", err)
+	}
+
+	if err := c.SetClientCertificate(certPEM, []byte("not a key")); err == nil {
+		t.Fatal("expected error for invalid private key, got nil")
+	}
+}
+
+func TestSetEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{"https://panel.example.com:8080", "https://panel.example.com:8080/remote/json.php", false},
+		{"https://panel.example.com/ispconfig/", "https://panel.example.com/ispconfig/remote/json.php", false},
+		{"http://10.0.0.5:8080/ispconfig/remote/json.php", "http://10.0.0.5:8080/ispconfig/remote/json.php", false},
+		{"panel.example.com:8080", "", true},
+		{"ftp://panel.example.com", "", true},
+		{"https://", "", true},
+		{"https://panel.example.com/remote/json.php?login", "", true},
+	}
+
+	for _, tt := range tests {
+		c := NewClient("unused", "admin", "secret", false)
+		err := c.SetEndpoint(tt.endpoint)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SetEndpoint(%q) error = %v, wantErr %v", tt.endpoint, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && c.baseURL != tt.want {
+			t.Errorf("SetEndpoint(%q) baseURL = %q, want %q", tt.endpoint, c.baseURL, tt.want)
+		}
+	}
+}
+
+func TestSetProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		_ = json.NewEncoder(w).Encode(APIResponse{Code: "ok"})
+	}))
+	defer proxy.Close()
+
+	c := NewClient("unused", "admin", "secret", false)
+	if err := c.SetEndpoint("http://panel.internal:8080/ispconfig"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.SetProxy(proxy.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp APIResponse
+	if err := c.makeRequest(context.Background(), "test", map[string]interface{}{}, &resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "http://panel.internal:8080/ispconfig/remote/json.php?test"; proxied != want {
+		t.Errorf("proxied request URL = %q, want %q", proxied, want)
+	}
+
+	if err := c.SetProxy("ftp://proxy.example.com"); err == nil {
+		t.Fatal("expected error for unsupported proxy scheme, got nil")
+	}
+}
+
+// testClientCertificate returns a PEM encoded self-signed certificate and key.
+func testClientCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
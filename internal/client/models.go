@@ -71,6 +71,7 @@ type WebDomain struct {
 	ParentDomainID  FlexInt `json:"parent_domain_id,omitempty"`
 	Vhost           string  `json:"vhost_type,omitempty"`
 	DocumentRoot    string  `json:"document_root,omitempty"`
+	WebFolder       string  `json:"web_folder,omitempty"` // vhostsubdomain/vhostalias: folder below the parent's web root
 	System          string  `json:"system_user,omitempty"`
 	SystemGroup     string  `json:"system_group,omitempty"`
 	HdQuota         FlexInt `json:"hd_quota,omitempty"`
@@ -95,6 +96,7 @@ type WebDomain struct {
 	SSLBundle       string  `json:"ssl_bundle,omitempty"`
 	SSLKey          string  `json:"ssl_key,omitempty"`
 	SSLAction       string  `json:"ssl_action,omitempty"`
+	SSLLetsencrypt  string  `json:"ssl_letsencrypt,omitempty"`
 	PHPVersion         string  `json:"php,omitempty"`
 	ServerPHPID        FlexInt `json:"server_php_id,omitempty"`
 	FastcgiPHPVersion  string  `json:"fastcgi_php_version,omitempty"`
@@ -120,8 +122,11 @@ type WebDomain struct {
 	HTTPSPort         FlexInt `json:"https_port,omitempty"`
 	PHPOpenBasedir    string  `json:"php_open_basedir,omitempty"`
 	ApacheDirectives       string  `json:"apache_directives,omitempty"`
+	NginxDirectives        string  `json:"nginx_directives,omitempty"`
+	ProxyDirectives        string  `json:"proxy_directives,omitempty"`
+	ProxyProtocol          string  `json:"proxy_protocol,omitempty"`
 	DisableSymlinkNotOwner string  `json:"disable_symlinknotowner,omitempty"`
-	CustomPHPIni           string  `json:"custom_php_ini,omitempty"`
+	CustomPHPIni           *string `json:"custom_php_ini,omitempty"` // pointer so an empty value can be sent to clear it
 	BackupInterval    string  `json:"backup_interval,omitempty"`
 	BackupCopies      FlexInt `json:"backup_copies,omitempty"`
 	Stats             string  `json:"stats_type,omitempty"`
@@ -148,6 +153,111 @@ type ShellUser struct {
 	PGroup         string  `json:"pgroup,omitempty"`  // System group from parent domain
 }
 
+// FTPUser represents an FTP user.
+// UID and GID must carry the system user and group of the parent web domain,
+// otherwise the FTP server cannot write to the web space.
+type FTPUser struct {
+	ID             FlexInt `json:"ftp_user_id,omitempty"`
+	SysUserID      FlexInt `json:"sys_userid,omitempty"`
+	SysGroupID     FlexInt `json:"sys_groupid,omitempty"`
+	ServerID       FlexInt `json:"server_id,omitempty"`
+	ParentDomainID FlexInt `json:"parent_domain_id"`
+	Username       string  `json:"username"`
+	Password       string  `json:"password,omitempty"`
+	QuotaSize      FlexInt `json:"quota_size"`
+	Active         string  `json:"active,omitempty"`
+	UID            string  `json:"uid,omitempty"`
+	GID            string  `json:"gid,omitempty"`
+	Dir            string  `json:"dir,omitempty"`
+	QuotaFiles     FlexInt `json:"quota_files,omitempty"`
+	ULRatio        FlexInt `json:"ul_ratio,omitempty"`
+	DLRatio        FlexInt `json:"dl_ratio,omitempty"`
+	ULBandwidth    FlexInt `json:"ul_bandwidth,omitempty"`
+	DLBandwidth    FlexInt `json:"dl_bandwidth,omitempty"`
+	Expires        string  `json:"expires"` // "YYYY-MM-DD HH:MM:SS"; always sent, so empty clears the expiry
+}
+
+// WebDAVUser represents a WebDAV user.
+// Username holds the full login name including UsernamePrefix; the remote API
+// does not apply ISPConfig's webdavuser_prefix on its own.
+type WebDAVUser struct {
+	ID             FlexInt `json:"webdav_user_id,omitempty"`
+	SysUserID      FlexInt `json:"sys_userid,omitempty"`
+	SysGroupID     FlexInt `json:"sys_groupid,omitempty"`
+	ServerID       FlexInt `json:"server_id,omitempty"`
+	ParentDomainID FlexInt `json:"parent_domain_id"`
+	Username       string  `json:"username"`
+	UsernamePrefix string  `json:"username_prefix"`
+	Password       string  `json:"password,omitempty"`
+	Active         string  `json:"active,omitempty"`
+	Dir            string  `json:"dir,omitempty"`
+}
+
+// WebFolder represents a password protected folder of a web domain
+type WebFolder struct {
+	ID             FlexInt `json:"web_folder_id,omitempty"`
+	SysUserID      FlexInt `json:"sys_userid,omitempty"`
+	SysGroupID     FlexInt `json:"sys_groupid,omitempty"`
+	ServerID       FlexInt `json:"server_id,omitempty"`
+	ParentDomainID FlexInt `json:"parent_domain_id"`
+	Path           string  `json:"path"`
+	Active         string  `json:"active,omitempty"`
+}
+
+// WebFolderUser represents a basic auth user of a protected web folder
+type WebFolderUser struct {
+	ID          FlexInt `json:"web_folder_user_id,omitempty"`
+	SysUserID   FlexInt `json:"sys_userid,omitempty"`
+	SysGroupID  FlexInt `json:"sys_groupid,omitempty"`
+	ServerID    FlexInt `json:"server_id,omitempty"`
+	WebFolderID FlexInt `json:"web_folder_id"`
+	Username    string  `json:"username"`
+	Password    string  `json:"password,omitempty"`
+	Active      string  `json:"active,omitempty"`
+}
+
+// WebBackup represents a backup of a website or database, as listed by
+// sites_web_domain_backup_list
+type WebBackup struct {
+	ID             FlexInt `json:"backup_id"`
+	ServerID       FlexInt `json:"server_id"`
+	ParentDomainID FlexInt `json:"parent_domain_id"`
+	BackupType     string  `json:"backup_type"` // "web", "mysql" or "mongodb"
+	BackupMode     string  `json:"backup_mode"`
+	BackupFormat   string  `json:"backup_format"`
+	Timestamp      FlexInt `json:"tstamp"`
+	Filename       string  `json:"filename"`
+	Filesize       FlexInt `json:"filesize"`
+}
+
+// WebQuota is the disk usage of a website as returned by
+// quota_get_by_user. Used, Soft and Hard are in KB as reported by repquota;
+// HdQuota is the configured quota in MB (-1 for unlimited).
+type WebQuota struct {
+	DomainID   FlexInt `json:"domain_id"`
+	Domain     string  `json:"domain"`
+	SystemUser string  `json:"system_user"`
+	HdQuota    FlexInt `json:"hd_quota"`
+	Used       FlexInt `json:"used"`
+	Soft       FlexInt `json:"soft"`
+	Hard       FlexInt `json:"hard"`
+	Files      FlexInt `json:"files"`
+}
+
+// WebTraffic is the HTTP or FTP traffic of a website as returned by
+// trafficquota_get_by_user and ftptrafficquota_data. Traffic is in bytes;
+// TrafficQuota is the configured quota in MB (-1 for unlimited) and is only
+// set for HTTP traffic.
+type WebTraffic struct {
+	DomainID     FlexInt `json:"domain_id"`
+	Domain       string  `json:"domain"`
+	TrafficQuota FlexInt `json:"traffic_quota"`
+	ThisMonth    FlexInt `json:"this_month"`
+	LastMonth    FlexInt `json:"last_month"`
+	ThisYear     FlexInt `json:"this_year"`
+	LastYear     FlexInt `json:"last_year"`
+}
+
 // Database represents a database
 type Database struct {
 	ID               FlexInt `json:"database_id,omitempty"`
@@ -237,80 +347,132 @@ type CronJob struct {
 }
 
 // ISPConfigClient represents an ISP Config client
+//
+// The contact fields managed by the ispconfig_client resource are always sent
+// so they can be cleared, since client_update merges omitted fields from the
+// stored record. Its limits are pointers: nil leaves the value to ISPConfig
+// (or the master template), while 0 means "none" and -1 unlimited.
 type ISPConfigClient struct {
-	ID                    FlexInt `json:"client_id,omitempty"`
-	SysUserID             FlexInt `json:"sys_userid,omitempty"`
-	SysGroupID            FlexInt `json:"sys_groupid,omitempty"`
-	ParentClientID        FlexInt `json:"parent_client_id,omitempty"`
-	CompanyName           string  `json:"company_name,omitempty"`
-	ContactName           string  `json:"contact_name,omitempty"`
-	CustomerNo            string  `json:"customer_no,omitempty"`
-	VATNumber             string  `json:"vat_id,omitempty"`
-	Street                string  `json:"street,omitempty"`
-	Zip                   string  `json:"zip,omitempty"`
-	City                  string  `json:"city,omitempty"`
-	State                 string  `json:"state,omitempty"`
-	Country               string  `json:"country,omitempty"`
-	Phone                 string  `json:"telephone,omitempty"`
-	Mobile                string  `json:"mobile,omitempty"`
-	Fax                   string  `json:"fax,omitempty"`
-	Email                 string  `json:"email,omitempty"`
-	Internet              string  `json:"internet,omitempty"`
-	ICQ                   string  `json:"icq,omitempty"`
-	Notes                 string  `json:"notes,omitempty"`
-	DefaultMailserver     FlexInt `json:"default_mailserver,omitempty"`
-	LimitMailDomain       FlexInt `json:"limit_maildomain,omitempty"`
-	LimitMailbox          FlexInt `json:"limit_mailbox,omitempty"`
-	LimitMailAlias        FlexInt `json:"limit_mailalias,omitempty"`
-	LimitMailAliasPattern FlexInt `json:"limit_mailaliasdomain,omitempty"`
-	LimitMailForward      FlexInt `json:"limit_mailforward,omitempty"`
-	LimitMailCatchall     FlexInt `json:"limit_mailcatchall,omitempty"`
-	LimitMailRouting      FlexInt `json:"limit_mailrouting,omitempty"`
-	LimitMailFilter       FlexInt `json:"limit_mailfilter,omitempty"`
-	LimitFetchmail        FlexInt `json:"limit_fetchmail,omitempty"`
-	LimitMailQuota        FlexInt `json:"limit_mailquota,omitempty"`
-	LimitSpamfilter       string  `json:"limit_spamfilter_wblist,omitempty"`
-	LimitSpamfilterUser   string  `json:"limit_spamfilter_user,omitempty"`
-	LimitSpamfilterPolicy string  `json:"limit_spamfilter_policy,omitempty"`
-	DefaultWebserver      FlexInt `json:"default_webserver,omitempty"`
-	LimitWeb              FlexInt `json:"limit_web_domain,omitempty"`
-	LimitWebQuota         FlexInt `json:"limit_web_quota,omitempty"`
-	WebPHP                string  `json:"web_php_options,omitempty"`
-	LimitCGI              string  `json:"limit_cgi,omitempty"`
-	LimitSSI              string  `json:"limit_ssi,omitempty"`
-	LimitPerl             string  `json:"limit_perl,omitempty"`
-	LimitRuby             string  `json:"limit_ruby,omitempty"`
-	LimitPython           string  `json:"limit_python,omitempty"`
-	ForceSubdomain        string  `json:"force_suexec,omitempty"`
-	LimitHTTPdirs         string  `json:"limit_hterror,omitempty"`
-	LimitWildcard         string  `json:"limit_wildcard,omitempty"`
-	LimitSSL              string  `json:"limit_ssl,omitempty"`
-	LimitSSLLetsencrypt   string  `json:"limit_ssl_letsencrypt,omitempty"`
-	LimitTrafficQuota     FlexInt `json:"limit_traffic_quota,omitempty"`
-	LimitWebAlias         FlexInt `json:"limit_web_aliasdomain,omitempty"`
-	LimitWebSubdomain     FlexInt `json:"limit_web_subdomain,omitempty"`
-	LimitFTPUser          FlexInt `json:"limit_ftp_user,omitempty"`
-	LimitShellUser        FlexInt `json:"limit_shell_user,omitempty"`
-	SSHChroot             string  `json:"ssh_chroot,omitempty"`
-	LimitWebdavUser       FlexInt `json:"limit_webdav_user,omitempty"`
-	DefaultDNSserver      FlexInt `json:"default_dnsserver,omitempty"`
-	LimitDNSZone          FlexInt `json:"limit_dns_zone,omitempty"`
-	LimitDNSSlaveZone     FlexInt `json:"limit_dns_slave_zone,omitempty"`
-	LimitDNSRecord        FlexInt `json:"limit_dns_record,omitempty"`
-	DefaultDBserver       FlexInt `json:"default_dbserver,omitempty"`
-	LimitDatabase         FlexInt `json:"limit_database,omitempty"`
-	LimitDatabaseQuota    FlexInt `json:"limit_database_quota,omitempty"`
-	LimitCronType         string  `json:"limit_cron_type,omitempty"`
-	LimitCron             FlexInt `json:"limit_cron,omitempty"`
-	LimitCronFrequency    FlexInt `json:"limit_cron_frequency,omitempty"`
-	Locked                string  `json:"locked,omitempty"`
-	Canceled              string  `json:"canceled,omitempty"`
-	Created               string  `json:"created,omitempty"`
-	Username              string  `json:"username,omitempty"`
-	Password              string  `json:"password,omitempty"`
-	Language              string  `json:"language,omitempty"`
-	UseTheme              string  `json:"usertheme,omitempty"`
-	TemplateMenu          string  `json:"template_master,omitempty"`
-	TemplateAdditional    string  `json:"template_additional,omitempty"`
-	CreatedAt             string  `json:"created_at,omitempty"`
+	ID                    FlexInt  `json:"client_id,omitempty"`
+	SysUserID             FlexInt  `json:"sys_userid,omitempty"`
+	SysGroupID            FlexInt  `json:"sys_groupid,omitempty"`
+	ParentClientID        FlexInt  `json:"parent_client_id,omitempty"`
+	CompanyName           string   `json:"company_name"`
+	ContactName           string   `json:"contact_name,omitempty"`
+	CustomerNo            string   `json:"customer_no,omitempty"`
+	VATNumber             string   `json:"vat_id"`
+	Street                string   `json:"street"`
+	Zip                   string   `json:"zip"`
+	City                  string   `json:"city"`
+	State                 string   `json:"state"`
+	Country               string   `json:"country,omitempty"`
+	Phone                 string   `json:"telephone"`
+	Mobile                string   `json:"mobile"`
+	Fax                   string   `json:"fax"`
+	Email                 string   `json:"email,omitempty"`
+	Internet              string   `json:"internet"`
+	ICQ                   string   `json:"icq,omitempty"`
+	Notes                 string   `json:"notes"`
+	DefaultMailserver     FlexInt  `json:"default_mailserver,omitempty"`
+	LimitMailDomain       *FlexInt `json:"limit_maildomain,omitempty"`
+	LimitMailbox          *FlexInt `json:"limit_mailbox,omitempty"`
+	LimitMailAlias        FlexInt  `json:"limit_mailalias,omitempty"`
+	LimitMailAliasPattern FlexInt  `json:"limit_mailaliasdomain,omitempty"`
+	LimitMailForward      FlexInt  `json:"limit_mailforward,omitempty"`
+	LimitMailCatchall     FlexInt  `json:"limit_mailcatchall,omitempty"`
+	LimitMailRouting      FlexInt  `json:"limit_mailrouting,omitempty"`
+	LimitMailFilter       FlexInt  `json:"limit_mailfilter,omitempty"`
+	LimitFetchmail        FlexInt  `json:"limit_fetchmail,omitempty"`
+	LimitMailQuota        *FlexInt `json:"limit_mailquota,omitempty"`
+	LimitSpamfilter       string   `json:"limit_spamfilter_wblist,omitempty"`
+	LimitSpamfilterUser   string   `json:"limit_spamfilter_user,omitempty"`
+	LimitSpamfilterPolicy string   `json:"limit_spamfilter_policy,omitempty"`
+	DefaultWebserver      FlexInt  `json:"default_webserver,omitempty"`
+	LimitWeb              *FlexInt `json:"limit_web_domain,omitempty"`
+	LimitWebQuota         *FlexInt `json:"limit_web_quota,omitempty"`
+	WebPHP                string   `json:"web_php_options,omitempty"`
+	LimitCGI              string   `json:"limit_cgi,omitempty"`
+	LimitSSI              string   `json:"limit_ssi,omitempty"`
+	LimitPerl             string   `json:"limit_perl,omitempty"`
+	LimitRuby             string   `json:"limit_ruby,omitempty"`
+	LimitPython           string   `json:"limit_python,omitempty"`
+	ForceSubdomain        string   `json:"force_suexec,omitempty"`
+	LimitHTTPdirs         string   `json:"limit_hterror,omitempty"`
+	LimitWildcard         string   `json:"limit_wildcard,omitempty"`
+	LimitSSL              string   `json:"limit_ssl,omitempty"`
+	LimitSSLLetsencrypt   string   `json:"limit_ssl_letsencrypt,omitempty"`
+	LimitTrafficQuota     *FlexInt `json:"limit_traffic_quota,omitempty"`
+	LimitWebAlias         *FlexInt `json:"limit_web_aliasdomain,omitempty"`
+	LimitWebSubdomain     *FlexInt `json:"limit_web_subdomain,omitempty"`
+	LimitFTPUser          *FlexInt `json:"limit_ftp_user,omitempty"`
+	LimitShellUser        *FlexInt `json:"limit_shell_user,omitempty"`
+	SSHChroot             string   `json:"ssh_chroot,omitempty"`
+	LimitWebdavUser       *FlexInt `json:"limit_webdav_user,omitempty"`
+	DefaultDNSserver      FlexInt  `json:"default_dnsserver,omitempty"`
+	LimitDNSZone          FlexInt  `json:"limit_dns_zone,omitempty"`
+	LimitDNSSlaveZone     FlexInt  `json:"limit_dns_slave_zone,omitempty"`
+	LimitDNSRecord        FlexInt  `json:"limit_dns_record,omitempty"`
+	DefaultDBserver       FlexInt  `json:"default_dbserver,omitempty"`
+	LimitDatabase         *FlexInt `json:"limit_database,omitempty"`
+	LimitDatabaseQuota    *FlexInt `json:"limit_database_quota,omitempty"`
+	LimitCronType         string   `json:"limit_cron_type,omitempty"`
+	LimitCron             *FlexInt `json:"limit_cron,omitempty"`
+	LimitCronFrequency    FlexInt  `json:"limit_cron_frequency,omitempty"`
+	LimitClient           *FlexInt `json:"limit_client,omitempty"` // non-zero for resellers
+	Locked                string   `json:"locked,omitempty"`
+	Canceled              string   `json:"canceled,omitempty"`
+	Created               string   `json:"created,omitempty"`
+	Username              string   `json:"username,omitempty"`
+	Password              string   `json:"password,omitempty"`
+	Language              string   `json:"language,omitempty"`
+	UseTheme              string   `json:"usertheme,omitempty"`
+	TemplateMaster        *FlexInt `json:"template_master,omitempty"` // nil keeps the stored template, 0 removes it
+	TemplateAdditional    string   `json:"template_additional,omitempty"`
+	CreatedAt             string   `json:"created_at,omitempty"`
+}
+
+// ClientTemplate represents a client template (hosting package) as returned
+// by client_templates_get_all. Limits holds the numeric limit_* columns of
+// the template, keyed by column name.
+type ClientTemplate struct {
+	ID     FlexInt        `json:"template_id"`
+	Name   string         `json:"template_name"`
+	Type   string         `json:"template_type"` // "m" (master) or "a" (additional)
+	Limits map[string]int `json:"-"`
+}
+
+// ClientTemplateAssignment represents an additional template assigned to a
+// client
+type ClientTemplateAssignment struct {
+	ID         FlexInt `json:"assigned_template_id"`
+	ClientID   FlexInt `json:"client_id"`
+	TemplateID FlexInt `json:"client_template_id"`
+}
+
+// Server represents a server of the ISPConfig installation. The ID and name
+// come from server_get_all, the role flags and mirror from
+// server_get_functions.
+type Server struct {
+	ID             FlexInt `json:"server_id"`
+	Name           string  `json:"server_name"`
+	WebServer      FlexInt `json:"web_server"`
+	MailServer     FlexInt `json:"mail_server"`
+	DBServer       FlexInt `json:"db_server"`
+	DNSServer      FlexInt `json:"dns_server"`
+	FileServer     FlexInt `json:"file_server"`
+	VServer        FlexInt `json:"vserver_server"`
+	ProxyServer    FlexInt `json:"proxy_server"`
+	FirewallServer FlexInt `json:"firewall_server"`
+	MirrorServerID FlexInt `json:"mirror_server_id"`
+}
+
+// ServerIP represents an IP address configured on an ISP Config server
+type ServerIP struct {
+	ID              FlexInt `json:"server_ip_id,omitempty"`
+	ServerID        FlexInt `json:"server_id"`
+	ClientID        FlexInt `json:"client_id"` // 0 if the IP is available to all clients
+	IPType          string  `json:"ip_type"`   // "IPv4" or "IPv6"
+	IPAddress       string  `json:"ip_address"`
+	VirtualHost     string  `json:"virtualhost"`      // "y" if web sites may use the IP
+	VirtualHostPort string  `json:"virtualhost_port"` // comma-separated, e.g. "80,443"
 }
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &backupRestoreAction{}
+	_ action.ActionWithConfigure = &backupRestoreAction{}
+)
+
+// NewBackupRestoreAction is a helper function to simplify the provider implementation.
+func NewBackupRestoreAction() action.Action {
+	return &backupRestoreAction{}
+}
+
+// backupRestoreAction is the action implementation.
+type backupRestoreAction struct {
+	client     *client.Client
+	resellerID int
+}
+
+// backupRestoreActionModel maps the action schema data.
+type backupRestoreActionModel struct {
+	BackupID       types.Int64 `tfsdk:"backup_id"`
+	ParentDomainID types.Int64 `tfsdk:"parent_domain_id"`
+}
+
+// Metadata returns the action type name.
+func (a *backupRestoreAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_restore"
+}
+
+// Schema defines the schema for the action.
+func (a *backupRestoreAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a website or database backup in ISP Config. The restore is queued and carried out by the server " +
+			"on its next cron run; it overwrites the current site files or database contents. Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"backup_id": schema.Int64Attribute{
+				Description: "The ID of the backup to restore (see the ispconfig_backups data source).",
+				Required:    true,
+			},
+			"parent_domain_id": schema.Int64Attribute{
+				Description: "The ID of the website the backup belongs to. Required when the provider acts for a reseller " +
+					"(reseller_id), so that the restore can be checked against the site's client.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *backupRestoreAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = providerData.Client
+	a.resellerID = providerData.ResellerID
+}
+
+// Invoke queues the restore of the backup.
+func (a *backupRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config backupRestoreActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupID := int(config.BackupID.ValueInt64())
+
+	resp.Diagnostics.Append(a.checkBackupSite(ctx, backupID, config.ParentDomainID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.RestoreWebBackup(ctx, backupID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring backup",
+			fmt.Sprintf("Could not restore backup ID %d: %s", backupID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Queued backup restore", map[string]interface{}{"id": backupID})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore of backup %d queued; the server applies it on its next cron run.", backupID),
+	})
+}
+
+// checkBackupSite verifies that the backup belongs to parent_domain_id and, when the
+// provider acts for a reseller, that the site belongs to one of its clients.
+// The remote API cannot look a backup up by its ID, so the site is needed.
+func (a *backupRestoreAction) checkBackupSite(ctx context.Context, backupID int, parentDomainID types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if parentDomainID.IsNull() {
+		if a.resellerID != 0 {
+			diags.AddAttributeError(
+				path.Root("parent_domain_id"),
+				"Missing Parent Domain ID",
+				fmt.Sprintf("parent_domain_id must be set to restore a backup while the provider acts for reseller ID %d.", a.resellerID),
+			)
+		}
+		return diags
+	}
+
+	site := int(parentDomainID.ValueInt64())
+	backups, err := a.client.GetWebBackups(ctx, site)
+	if err != nil {
+		diags.AddError(
+			"Error reading backups",
+			fmt.Sprintf("Could not list the backups of site ID %d: %s", site, err.Error()),
+		)
+		return diags
+	}
+	found := false
+	for _, backup := range backups {
+		if int(backup.ID) == backupID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		diags.AddAttributeError(
+			path.Root("backup_id"),
+			"Backup Not Found",
+			fmt.Sprintf("Site ID %d has no backup ID %d.", site, backupID),
+		)
+		return diags
+	}
+	if a.resellerID == 0 {
+		return diags
+	}
+
+	domain, err := a.client.GetWebDomain(ctx, site)
+	if err != nil {
+		diags.AddError(
+			"Error reading web hosting",
+			fmt.Sprintf("Could not read site ID %d: %s", site, err.Error()),
+		)
+		return diags
+	}
+	clientID, err := a.client.GetClientIDByUser(ctx, int(domain.SysUserID))
+	if err != nil {
+		diags.AddError(
+			"Error checking client ownership",
+			fmt.Sprintf("Could not read the client of site ID %d: %s", site, err.Error()),
+		)
+		return diags
+	}
+	diags.Append(checkClientOwnership(ctx, a.client, a.resellerID, clientID)...)
+	return diags
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &backupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &backupsDataSource{}
+)
+
+// backupTypes lists the backup_type values ISPConfig stores in web_backup.
+var backupTypes = []string{"web", "mysql", "mongodb"}
+
+// NewBackupsDataSource is a helper function to simplify the provider implementation.
+func NewBackupsDataSource() datasource.DataSource {
+	return &backupsDataSource{}
+}
+
+// backupsDataSource is the data source implementation.
+type backupsDataSource struct {
+	client *client.Client
+}
+
+// backupsDataSourceModel maps the data source schema data.
+type backupsDataSourceModel struct {
+	ParentDomainID types.Int64   `tfsdk:"parent_domain_id"`
+	Type           types.String  `tfsdk:"type"`
+	DatabaseName   types.String  `tfsdk:"database_name"`
+	Backups        []backupModel `tfsdk:"backups"`
+}
+
+// backupModel maps a single backup.
+type backupModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Type     types.String `tfsdk:"type"`
+	Date     types.String `tfsdk:"date"`
+	Size     types.Int64  `tfsdk:"size"`
+	Filename types.String `tfsdk:"filename"`
+	Mode     types.String `tfsdk:"mode"`
+	Format   types.String `tfsdk:"format"`
+	ServerID types.Int64  `tfsdk:"server_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *backupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+// Schema defines the schema for the data source.
+func (d *backupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the backups of a website and its databases in ISP Config, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"parent_domain_id": schema.Int64Attribute{
+				Description: "The ID of the web hosting domain whose backups are listed.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only list backups of this type: 'web', 'mysql' or 'mongodb'.",
+				Optional:    true,
+			},
+			"database_name": schema.StringAttribute{
+				Description: "Only list backups of this database (implies type 'mysql').",
+				Optional:    true,
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "The backups, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the backup.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The backup type: 'web', 'mysql' or 'mongodb'.",
+							Computed:    true,
+						},
+						"date": schema.StringAttribute{
+							Description: "When the backup was created (RFC 3339).",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "The backup file size in bytes.",
+							Computed:    true,
+						},
+						"filename": schema.StringAttribute{
+							Description: "The backup file name.",
+							Computed:    true,
+						},
+						"mode": schema.StringAttribute{
+							Description: "The backup mode (e.g. 'rootgz', 'userzip').",
+							Computed:    true,
+						},
+						"format": schema.StringAttribute{
+							Description: "The backup archive format.",
+							Computed:    true,
+						},
+						"server_id": schema.Int64Attribute{
+							Description: "The server the backup is stored on.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *backupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config backupsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupType := config.Type.ValueString()
+	databaseName := config.DatabaseName.ValueString()
+	if databaseName != "" {
+		if backupType != "" && backupType != "mysql" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Conflicting Configuration",
+				"database_name only applies to backups of type 'mysql'.",
+			)
+			return
+		}
+		backupType = "mysql"
+	}
+	if backupType != "" {
+		valid := false
+		for _, t := range backupTypes {
+			valid = valid || backupType == t
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Invalid Backup Type",
+				fmt.Sprintf("type must be one of %s (got %q).", strings.Join(backupTypes, ", "), backupType),
+			)
+			return
+		}
+	}
+
+	siteID := int(config.ParentDomainID.ValueInt64())
+
+	backups, err := d.client.GetWebBackups(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading backups",
+			fmt.Sprintf("Could not list backups of web hosting ID %d: %s", siteID, err.Error()),
+		)
+		return
+	}
+
+	backups = filterBackups(backups, backupType, databaseName)
+
+	config.Backups = make([]backupModel, 0, len(backups))
+	for _, b := range backups {
+		config.Backups = append(config.Backups, backupModel{
+			ID:       types.Int64Value(int64(b.ID)),
+			Type:     types.StringValue(b.BackupType),
+			Date:     types.StringValue(time.Unix(int64(b.Timestamp), 0).UTC().Format(time.RFC3339)),
+			Size:     types.Int64Value(int64(b.Filesize)),
+			Filename: types.StringValue(b.Filename),
+			Mode:     types.StringValue(b.BackupMode),
+			Format:   types.StringValue(b.BackupFormat),
+			ServerID: types.Int64Value(int64(b.ServerID)),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterBackups returns the backups matching the type and database name
+// (both optional), newest first. ISPConfig names database backups
+// "db_<database name>_<YYYY-MM-DD_HH-MM>.sql.gz"; the date is matched too, so
+// that e.g. "app" does not select the backups of "app_x".
+func filterBackups(backups []client.WebBackup, backupType, databaseName string) []client.WebBackup {
+	dbFile := regexp.MustCompile(`^db_` + regexp.QuoteMeta(databaseName) + `_\d{4}-\d{2}-\d{2}_\d{2}-\d{2}`)
+	filtered := make([]client.WebBackup, 0, len(backups))
+	for _, b := range backups {
+		if backupType != "" && b.BackupType != backupType {
+			continue
+		}
+		if databaseName != "" && !dbFile.MatchString(b.Filename) {
+			continue
+		}
+		filtered = append(filtered, b)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Timestamp != filtered[j].Timestamp {
+			return filtered[i].Timestamp > filtered[j].Timestamp
+		}
+		return filtered[i].ID > filtered[j].ID
+	})
+	return filtered
+}
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -29,7 +31,7 @@ type clientDataSource struct {
 
 // clientDataSourceModel maps the data source schema data.
 type clientDataSourceModel struct {
-	ID                int64        `tfsdk:"id"`
+	ID                types.Int64  `tfsdk:"id"`
 	CompanyName       types.String `tfsdk:"company_name"`
 	ContactName       types.String `tfsdk:"contact_name"`
 	CustomerNo        types.String `tfsdk:"customer_no"`
@@ -63,14 +65,16 @@ func (d *clientDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 // Schema defines the schema for the data source.
 func (d *clientDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches an ISP Config client (customer) from ISP Config.",
+		Description: "Fetches an ISP Config client (customer) from ISP Config by id, username, customer_no or company_name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Description: "The ID of the client.",
-				Required:    true,
+				Description: "The ID of the client. Exactly one of id, username, customer_no or company_name must be set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"company_name": schema.StringAttribute{
-				Description: "The company name.",
+				Description: "The company name. Exactly one of id, username, customer_no or company_name must be set; the lookup fails if several clients match.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"contact_name": schema.StringAttribute{
@@ -78,7 +82,8 @@ func (d *clientDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 			},
 			"customer_no": schema.StringAttribute{
-				Description: "The customer number.",
+				Description: "The customer number. Exactly one of id, username, customer_no or company_name must be set. Requires ISPConfig 3.1 or later.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"vat_number": schema.StringAttribute{
@@ -126,7 +131,8 @@ func (d *clientDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 			},
 			"username": schema.StringAttribute{
-				Description: "The username.",
+				Description: "The panel login name. Exactly one of id, username, customer_no or company_name must be set.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"locked": schema.StringAttribute{
@@ -192,18 +198,89 @@ func (d *clientDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	clientID := int(config.ID)
-
-	ispClient, err := d.client.GetClient(ctx, clientID)
-	if err != nil {
+	lookups := 0
+	for _, set := range []bool{!config.ID.IsNull(), !config.Username.IsNull(), !config.CustomerNo.IsNull(), !config.CompanyName.IsNull()} {
+		if set {
+			lookups++
+		}
+	}
+	if lookups != 1 {
 		resp.Diagnostics.AddError(
-			"Error reading client",
-			fmt.Sprintf("Could not read client ID %d: %s", clientID, err.Error()),
+			"Invalid Client Lookup",
+			"Exactly one of id, username, customer_no or company_name must be set.",
 		)
 		return
 	}
 
+	var ispClient *client.ISPConfigClient
+	var err error
+	switch {
+	case !config.ID.IsNull():
+		ispClient, err = d.client.GetClient(ctx, int(config.ID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading client",
+				fmt.Sprintf("Could not read client ID %d: %s", config.ID.ValueInt64(), err.Error()),
+			)
+			return
+		}
+	case !config.Username.IsNull():
+		ispClient, err = d.client.GetClientByUsername(ctx, config.Username.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading client",
+				fmt.Sprintf("Could not read client with username %q: %s", config.Username.ValueString(), err.Error()),
+			)
+			return
+		}
+	case !config.CustomerNo.IsNull():
+		ispClient, err = d.client.GetClientByCustomerNo(ctx, config.CustomerNo.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading client",
+				fmt.Sprintf("Could not read client with customer_no %q: %s", config.CustomerNo.ValueString(), err.Error()),
+			)
+			return
+		}
+	default:
+		// The remote API has no lookup by company name, so all clients are
+		// scanned
+		value := config.CompanyName.ValueString()
+
+		clients, err := d.client.GetAllClients(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading clients",
+				"Could not read clients: "+err.Error(),
+			)
+			return
+		}
+
+		var ids []string
+		for i := range clients {
+			if clients[i].CompanyName == value {
+				ispClient = &clients[i]
+				ids = append(ids, strconv.Itoa(int(clients[i].ID)))
+			}
+		}
+		if len(ids) == 0 {
+			resp.Diagnostics.AddError(
+				"Client Not Found",
+				fmt.Sprintf("No client with company_name %q exists.", value),
+			)
+			return
+		}
+		if len(ids) > 1 {
+			resp.Diagnostics.AddError(
+				"Ambiguous Client Lookup",
+				fmt.Sprintf("%d clients have company_name %q (IDs %s). Look the client up by id, username or customer_no instead.", len(ids), value, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
 	// Map response to data source model
+	config.ID = types.Int64Value(int64(ispClient.ID))
 	config.CompanyName = types.StringValue(ispClient.CompanyName)
 	config.ContactName = types.StringValue(ispClient.ContactName)
 	config.CustomerNo = types.StringValue(ispClient.CustomerNo)
@@ -237,18 +314,18 @@ func (d *clientDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	} else {
 		config.DefaultDBserver = types.Int64Null()
 	}
-	if ispClient.LimitWeb != 0 {
-		config.LimitWeb = types.Int64Value(int64(ispClient.LimitWeb))
+	if ispClient.LimitWeb != nil && *ispClient.LimitWeb != 0 {
+		config.LimitWeb = types.Int64Value(int64(*ispClient.LimitWeb))
 	} else {
 		config.LimitWeb = types.Int64Null()
 	}
-	if ispClient.LimitDatabase != 0 {
-		config.LimitDatabase = types.Int64Value(int64(ispClient.LimitDatabase))
+	if ispClient.LimitDatabase != nil && *ispClient.LimitDatabase != 0 {
+		config.LimitDatabase = types.Int64Value(int64(*ispClient.LimitDatabase))
 	} else {
 		config.LimitDatabase = types.Int64Null()
 	}
-	if ispClient.LimitFTPUser != 0 {
-		config.LimitFTPUser = types.Int64Value(int64(ispClient.LimitFTPUser))
+	if ispClient.LimitFTPUser != nil && *ispClient.LimitFTPUser != 0 {
+		config.LimitFTPUser = types.Int64Value(int64(*ispClient.LimitFTPUser))
 	} else {
 		config.LimitFTPUser = types.Int64Null()
 	}
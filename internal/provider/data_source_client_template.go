@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clientTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &clientTemplateDataSource{}
+)
+
+// NewClientTemplateDataSource is a helper function to simplify the provider implementation.
+func NewClientTemplateDataSource() datasource.DataSource {
+	return &clientTemplateDataSource{}
+}
+
+// clientTemplateDataSource is the data source implementation.
+type clientTemplateDataSource struct {
+	client *client.Client
+}
+
+// clientTemplateDataSourceModel maps the data source schema data.
+type clientTemplateDataSourceModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Limits types.Map    `tfsdk:"limits"`
+}
+
+// Metadata returns the data source type name.
+func (d *clientTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *clientTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an ISP Config client template (hosting package) by ID or name. " +
+			"Templates are managed in the ISP Config panel; the remote API can only read and assign them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the template. Either id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the template. Either id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The template type: 'master' or 'additional'.",
+				Computed:    true,
+			},
+			"limits": schema.MapAttribute{
+				Description: "The numeric limits of the template, keyed by ISP Config column name (e.g. 'limit_web_domain'); -1 means unlimited.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *clientTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *clientTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config clientTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Template Lookup",
+			"Exactly one of id or name must be set.",
+		)
+		return
+	}
+
+	templates, err := d.client.GetClientTemplates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading client templates",
+			"Could not read client templates: "+err.Error(),
+		)
+		return
+	}
+
+	var template *client.ClientTemplate
+	for i := range templates {
+		if (!config.ID.IsNull() && int64(templates[i].ID) == config.ID.ValueInt64()) ||
+			(!config.Name.IsNull() && templates[i].Name == config.Name.ValueString()) {
+			template = &templates[i]
+			break
+		}
+	}
+	if template == nil {
+		lookup := fmt.Sprintf("ID %d", config.ID.ValueInt64())
+		if !config.Name.IsNull() {
+			lookup = fmt.Sprintf("name %q", config.Name.ValueString())
+		}
+		resp.Diagnostics.AddError(
+			"Client Template Not Found",
+			fmt.Sprintf("No client template with %s exists.", lookup),
+		)
+		return
+	}
+
+	config.ID = types.Int64Value(int64(template.ID))
+	config.Name = types.StringValue(template.Name)
+	config.Type = types.StringValue("master")
+	if template.Type == "a" {
+		config.Type = types.StringValue("additional")
+	}
+
+	limits := make(map[string]int64, len(template.Limits))
+	for k, v := range template.Limits {
+		limits[k] = int64(v)
+	}
+	config.Limits, diags = types.MapValueFrom(ctx, types.Int64Type, limits)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
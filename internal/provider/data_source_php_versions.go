@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &phpVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &phpVersionsDataSource{}
+)
+
+// NewPHPVersionsDataSource is a helper function to simplify the provider implementation.
+func NewPHPVersionsDataSource() datasource.DataSource {
+	return &phpVersionsDataSource{}
+}
+
+// phpVersionsDataSource is the data source implementation.
+type phpVersionsDataSource struct {
+	client   *client.Client
+	serverID int
+}
+
+// phpVersionsDataSourceModel maps the data source schema data.
+type phpVersionsDataSourceModel struct {
+	ServerID types.Int64       `tfsdk:"server_id"`
+	Handler  types.String      `tfsdk:"handler"`
+	Prefix   types.String      `tfsdk:"prefix"`
+	Versions []phpVersionModel `tfsdk:"versions"`
+	Latest   types.String      `tfsdk:"latest"`
+}
+
+// phpVersionModel maps a PHP version available on the server.
+type phpVersionModel struct {
+	Handler types.String `tfsdk:"handler"`
+	Version types.String `tfsdk:"version"`
+	Info    types.String `tfsdk:"info"`
+}
+
+// Metadata returns the data source type name.
+func (d *phpVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_php_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *phpVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the additional PHP versions configured on an ISP Config server, per PHP handler. " +
+			"The version strings are the values accepted by php_version of ispconfig_web_hosting.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Description: "The ISP Config server ID. Defaults to the provider server_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"handler": schema.StringAttribute{
+				Description: "Only list the versions of this PHP handler: 'php-fpm' or 'fast-cgi'. Lists both by default.",
+				Optional:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Only list versions starting with this prefix (e.g. '8.' for PHP 8.x).",
+				Optional:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "The PHP versions, ordered by handler and then by ascending version number.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"handler": schema.StringAttribute{
+							Description: "The PHP handler: 'php-fpm' or 'fast-cgi'.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "The PHP version (e.g. '8.4').",
+							Computed:    true,
+						},
+						"info": schema.StringAttribute{
+							Description: "The full info string ISP Config stores for the version (name, init script, ini and pool directories).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"latest": schema.StringAttribute{
+				Description: "The highest version in versions, or an empty string if there is none.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *phpVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.serverID = providerData.ServerID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *phpVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config phpVersionsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Determine server ID
+	serverID := d.serverID
+	if !config.ServerID.IsNull() {
+		serverID = int(config.ServerID.ValueInt64())
+	}
+
+	if serverID == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Server ID",
+			"Server ID must be set either in the provider configuration or in the data source configuration.",
+		)
+		return
+	}
+
+	handlers := phpHandlers
+	if !config.Handler.IsNull() {
+		handler := config.Handler.ValueString()
+		valid := false
+		for _, h := range phpHandlers {
+			valid = valid || h == handler
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("handler"),
+				"Invalid PHP Handler",
+				fmt.Sprintf("handler must be one of %s, got %q.", strings.Join(phpHandlers, ", "), handler),
+			)
+			return
+		}
+		handlers = []string{handler}
+	}
+
+	config.Versions = []phpVersionModel{}
+	latest := ""
+	for _, handler := range handlers {
+		versions, err := d.client.GetPHPVersions(ctx, serverID, handler)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading PHP versions",
+				fmt.Sprintf("Could not read %s PHP versions of server ID %d: %s", handler, serverID, err.Error()),
+			)
+			return
+		}
+
+		names := make([]string, 0, len(versions))
+		for version := range versions {
+			if strings.HasPrefix(version, config.Prefix.ValueString()) {
+				names = append(names, version)
+			}
+		}
+		sort.Slice(names, func(i, j int) bool {
+			return comparePHPVersions(names[i], names[j]) < 0
+		})
+
+		for _, version := range names {
+			config.Versions = append(config.Versions, phpVersionModel{
+				Handler: types.StringValue(handler),
+				Version: types.StringValue(version),
+				Info:    types.StringValue(versions[version]),
+			})
+			if latest == "" || comparePHPVersions(version, latest) > 0 {
+				latest = version
+			}
+		}
+	}
+
+	config.ServerID = types.Int64Value(int64(serverID))
+	config.Latest = types.StringValue(latest)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
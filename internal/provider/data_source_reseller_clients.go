@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &resellerClientsDataSource{}
+	_ datasource.DataSourceWithConfigure = &resellerClientsDataSource{}
+)
+
+// NewResellerClientsDataSource is a helper function to simplify the provider implementation.
+func NewResellerClientsDataSource() datasource.DataSource {
+	return &resellerClientsDataSource{}
+}
+
+// resellerClientsDataSource is the data source implementation.
+type resellerClientsDataSource struct {
+	client     *client.Client
+	resellerID int
+}
+
+// resellerClientsDataSourceModel maps the data source schema data.
+type resellerClientsDataSourceModel struct {
+	ResellerID types.Int64                 `tfsdk:"reseller_id"`
+	Clients    []resellerClientsEntryModel `tfsdk:"clients"`
+}
+
+// resellerClientsEntryModel maps a single client of the reseller.
+type resellerClientsEntryModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	CompanyName types.String `tfsdk:"company_name"`
+	ContactName types.String `tfsdk:"contact_name"`
+	CustomerNo  types.String `tfsdk:"customer_no"`
+	Email       types.String `tfsdk:"email"`
+	Locked      types.Bool   `tfsdk:"locked"`
+	Canceled    types.Bool   `tfsdk:"canceled"`
+}
+
+// Metadata returns the data source type name.
+func (d *resellerClientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reseller_clients"
+}
+
+// Schema defines the schema for the data source.
+func (d *resellerClientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the clients of an ISP Config reseller.",
+		Attributes: map[string]schema.Attribute{
+			"reseller_id": schema.Int64Attribute{
+				Description: "The ISP Config reseller ID. Defaults to the provider reseller_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"clients": schema.ListNestedAttribute{
+				Description: "The clients of the reseller.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the client.",
+							Computed:    true,
+						},
+						"username":     computedString("The panel login name."),
+						"company_name": computedString("The company name."),
+						"contact_name": computedString("The contact name."),
+						"customer_no":  computedString("The customer number."),
+						"email":        computedString("The email address."),
+						"locked": schema.BoolAttribute{
+							Description: "Whether the client is locked.",
+							Computed:    true,
+						},
+						"canceled": schema.BoolAttribute{
+							Description: "Whether the client is canceled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *resellerClientsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.resellerID = providerData.ResellerID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *resellerClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config resellerClientsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Determine reseller ID
+	resellerID := d.resellerID
+	if !config.ResellerID.IsNull() {
+		resellerID = int(config.ResellerID.ValueInt64())
+	}
+
+	if resellerID == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Reseller ID",
+			"Reseller ID must be set either in the provider configuration or in the data source configuration.",
+		)
+		return
+	}
+
+	clients, err := d.client.GetAllClients(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading clients",
+			"Could not read clients: "+err.Error(),
+		)
+		return
+	}
+
+	config.ResellerID = types.Int64Value(int64(resellerID))
+	config.Clients = filterResellerClients(clients, resellerID)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterResellerClients returns the clients whose parent is the reseller, in
+// the order returned by the API.
+func filterResellerClients(clients []client.ISPConfigClient, resellerID int) []resellerClientsEntryModel {
+	result := []resellerClientsEntryModel{}
+	for _, c := range clients {
+		if int(c.ParentClientID) != resellerID {
+			continue
+		}
+		result = append(result, resellerClientsEntryModel{
+			ID:          types.Int64Value(int64(c.ID)),
+			Username:    types.StringValue(c.Username),
+			CompanyName: types.StringValue(c.CompanyName),
+			ContactName: types.StringValue(c.ContactName),
+			CustomerNo:  types.StringValue(c.CustomerNo),
+			Email:       types.StringValue(c.Email),
+			Locked:      types.BoolValue(ynToBool(c.Locked)),
+			Canceled:    types.BoolValue(ynToBool(c.Canceled)),
+		})
+	}
+	return result
+}
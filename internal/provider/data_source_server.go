@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverDataSource{}
+)
+
+// NewServerDataSource is a helper function to simplify the provider implementation.
+func NewServerDataSource() datasource.DataSource {
+	return &serverDataSource{}
+}
+
+// serverDataSource is the data source implementation.
+type serverDataSource struct {
+	client *client.Client
+}
+
+// serverModel maps a server; it is shared by the ispconfig_server and
+// ispconfig_servers data sources.
+type serverModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Web            types.Bool   `tfsdk:"web"`
+	Mail           types.Bool   `tfsdk:"mail"`
+	DB             types.Bool   `tfsdk:"db"`
+	DNS            types.Bool   `tfsdk:"dns"`
+	File           types.Bool   `tfsdk:"file"`
+	VServer        types.Bool   `tfsdk:"vserver"`
+	Proxy          types.Bool   `tfsdk:"proxy"`
+	Firewall       types.Bool   `tfsdk:"firewall"`
+	Services       []string     `tfsdk:"services"`
+	MirrorServerID types.Int64  `tfsdk:"mirror_server_id"`
+	Mirrors        []int64      `tfsdk:"mirrors"`
+}
+
+// serverRoles lists the server roles in the order they are reported in
+// services.
+var serverRoles = []string{"web", "mail", "db", "dns", "file", "vserver", "proxy", "firewall"}
+
+// hasRole reports whether the server has the given role.
+func (m serverModel) hasRole(role string) bool {
+	switch role {
+	case "web":
+		return m.Web.ValueBool()
+	case "mail":
+		return m.Mail.ValueBool()
+	case "db":
+		return m.DB.ValueBool()
+	case "dns":
+		return m.DNS.ValueBool()
+	case "file":
+		return m.File.ValueBool()
+	case "vserver":
+		return m.VServer.ValueBool()
+	case "proxy":
+		return m.Proxy.ValueBool()
+	case "firewall":
+		return m.Firewall.ValueBool()
+	}
+	return false
+}
+
+// newServerModels converts the API servers and links each server to the
+// servers that mirror it.
+func newServerModels(servers []client.Server) []serverModel {
+	mirrors := map[int][]int64{}
+	for _, s := range servers {
+		if s.MirrorServerID != 0 {
+			mirrors[int(s.MirrorServerID)] = append(mirrors[int(s.MirrorServerID)], int64(s.ID))
+		}
+	}
+
+	result := make([]serverModel, 0, len(servers))
+	for _, s := range servers {
+		m := serverModel{
+			ID:             types.Int64Value(int64(s.ID)),
+			Name:           types.StringValue(s.Name),
+			Web:            types.BoolValue(s.WebServer == 1),
+			Mail:           types.BoolValue(s.MailServer == 1),
+			DB:             types.BoolValue(s.DBServer == 1),
+			DNS:            types.BoolValue(s.DNSServer == 1),
+			File:           types.BoolValue(s.FileServer == 1),
+			VServer:        types.BoolValue(s.VServer == 1),
+			Proxy:          types.BoolValue(s.ProxyServer == 1),
+			Firewall:       types.BoolValue(s.FirewallServer == 1),
+			Services:       []string{},
+			MirrorServerID: types.Int64Value(int64(s.MirrorServerID)),
+			Mirrors:        mirrors[int(s.ID)],
+		}
+		if m.Mirrors == nil {
+			m.Mirrors = []int64{}
+		}
+		for _, role := range serverRoles {
+			if m.hasRole(role) {
+				m.Services = append(m.Services, role)
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// serverAttributes returns the computed attributes of a server. The id and
+// name are added by the data sources.
+func serverAttributes() map[string]schema.Attribute {
+	role := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"web":      role("Whether the server is a web server."),
+		"mail":     role("Whether the server is a mail server."),
+		"db":       role("Whether the server is a database server."),
+		"dns":      role("Whether the server is a DNS server."),
+		"file":     role("Whether the server is a file server."),
+		"vserver":  role("Whether the server is a virtual server (OpenVZ) host."),
+		"proxy":    role("Whether the server is a proxy server."),
+		"firewall": role("Whether the server is a firewall server."),
+		"services": schema.ListAttribute{
+			Description: "The roles of the server: 'web', 'mail', 'db', 'dns', 'file', 'vserver', 'proxy' and/or 'firewall'.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"mirror_server_id": schema.Int64Attribute{
+			Description: "The ID of the server this server mirrors, 0 if it is not a mirror.",
+			Computed:    true,
+		},
+		"mirrors": schema.ListAttribute{
+			Description: "The IDs of the servers that mirror this server.",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *serverDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serverAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Description: "The ID of the server. Either id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the server (e.g. 'server1.example.com'). Either id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches an ISP Config server by ID or name, with its roles and mirror relationships.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serverDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Server Lookup",
+			"Exactly one of id or name must be set.",
+		)
+		return
+	}
+
+	// All servers are read to resolve names and mirrors
+	servers, err := d.client.GetServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading servers",
+			"Could not read servers: "+err.Error(),
+		)
+		return
+	}
+
+	for _, server := range newServerModels(servers) {
+		if (!config.ID.IsNull() && server.ID.Equal(config.ID)) ||
+			(!config.Name.IsNull() && server.Name.Equal(config.Name)) {
+			diags = resp.State.Set(ctx, &server)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	lookup := fmt.Sprintf("ID %d", config.ID.ValueInt64())
+	if !config.Name.IsNull() {
+		lookup = fmt.Sprintf("name %q", config.Name.ValueString())
+	}
+	resp.Diagnostics.AddError(
+		"Server Not Found",
+		fmt.Sprintf("No server with %s exists.", lookup),
+	)
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverIPDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverIPDataSource{}
+)
+
+// NewServerIPDataSource is a helper function to simplify the provider implementation.
+func NewServerIPDataSource() datasource.DataSource {
+	return &serverIPDataSource{}
+}
+
+// serverIPDataSource is the data source implementation.
+type serverIPDataSource struct {
+	client *client.Client
+}
+
+// serverIPModel maps a server IP address; it is shared by the
+// ispconfig_server_ip resource and the ispconfig_server_ip and
+// ispconfig_server_ips data sources.
+type serverIPModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	ServerID        types.Int64  `tfsdk:"server_id"`
+	ClientID        types.Int64  `tfsdk:"client_id"`
+	IPType          types.String `tfsdk:"ip_type"`
+	IPAddress       types.String `tfsdk:"ip_address"`
+	VirtualHost     types.Bool   `tfsdk:"virtualhost"`
+	VirtualHostPort types.String `tfsdk:"virtualhost_port"`
+}
+
+// newServerIPModel converts an API server IP.
+func newServerIPModel(ip client.ServerIP) serverIPModel {
+	return serverIPModel{
+		ID:              types.Int64Value(int64(ip.ID)),
+		ServerID:        types.Int64Value(int64(ip.ServerID)),
+		ClientID:        types.Int64Value(int64(ip.ClientID)),
+		IPType:          types.StringValue(ip.IPType),
+		IPAddress:       types.StringValue(ip.IPAddress),
+		VirtualHost:     types.BoolValue(ynToBool(ip.VirtualHost)),
+		VirtualHostPort: types.StringValue(ip.VirtualHostPort),
+	}
+}
+
+// serverIPAttributes returns the computed attributes of a server IP. The
+// lookup attributes id, server_id and ip_address are added by the data
+// sources.
+func serverIPAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"client_id": schema.Int64Attribute{
+			Description: "The ID of the client the IP address is reserved for, 0 if it is available to all clients.",
+			Computed:    true,
+		},
+		"ip_type": schema.StringAttribute{
+			Description: "The IP version: 'IPv4' or 'IPv6'.",
+			Computed:    true,
+		},
+		"virtualhost": schema.BoolAttribute{
+			Description: "Whether web sites may use the IP address (HTTP NameVirtualHost).",
+			Computed:    true,
+		},
+		"virtualhost_port": schema.StringAttribute{
+			Description: "The comma-separated ports web sites listen on at the IP address (e.g. '80,443').",
+			Computed:    true,
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *serverIPDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_ip"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverIPDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serverIPAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Description: "The ID of the server IP. Either id or ip_address must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["ip_address"] = schema.StringAttribute{
+		Description: "The IP address. Either id or ip_address must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["server_id"] = schema.Int64Attribute{
+		Description: "The ID of the server. When looking up by ip_address, only this server is searched; by default all servers are.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches an IP address configured on an ISP Config server, by ID or address.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serverIPDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverIPDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverIPModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ID.IsNull() == config.IPAddress.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Server IP Lookup",
+			"Exactly one of id or ip_address must be set.",
+		)
+		return
+	}
+
+	if !config.ID.IsNull() {
+		ipID := int(config.ID.ValueInt64())
+		ip, err := d.client.GetServerIP(ctx, ipID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading server IP",
+				fmt.Sprintf("Could not read server IP ID %d: %s", ipID, err.Error()),
+			)
+			return
+		}
+
+		state := newServerIPModel(*ip)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	serverID := int(config.ServerID.ValueInt64())
+	ips, err := d.client.GetServerIPs(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading server IPs",
+			"Could not read server IPs: "+err.Error(),
+		)
+		return
+	}
+
+	var matches []client.ServerIP
+	for _, ip := range ips {
+		if ip.IPAddress == config.IPAddress.ValueString() {
+			matches = append(matches, ip)
+		}
+	}
+
+	if len(matches) == 0 {
+		where := "any server"
+		if serverID != 0 {
+			where = fmt.Sprintf("server ID %d", serverID)
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_address"),
+			"Server IP Not Found",
+			fmt.Sprintf("IP address %s is not configured on %s.", config.IPAddress.ValueString(), where),
+		)
+		return
+	}
+	if len(matches) > 1 {
+		servers := make([]string, 0, len(matches))
+		for _, ip := range matches {
+			servers = append(servers, fmt.Sprint(ip.ServerID))
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_address"),
+			"Ambiguous Server IP Lookup",
+			fmt.Sprintf("IP address %s is configured on servers %s. Set server_id to select one.",
+				config.IPAddress.ValueString(), strings.Join(servers, ", ")),
+		)
+		return
+	}
+
+	state := newServerIPModel(matches[0])
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
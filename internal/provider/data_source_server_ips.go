@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverIPsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverIPsDataSource{}
+)
+
+// NewServerIPsDataSource is a helper function to simplify the provider implementation.
+func NewServerIPsDataSource() datasource.DataSource {
+	return &serverIPsDataSource{}
+}
+
+// serverIPsDataSource is the data source implementation.
+type serverIPsDataSource struct {
+	client *client.Client
+}
+
+// serverIPsDataSourceModel maps the data source schema data.
+type serverIPsDataSourceModel struct {
+	ServerID    types.Int64     `tfsdk:"server_id"`
+	IPType      types.String    `tfsdk:"ip_type"`
+	VirtualHost types.Bool      `tfsdk:"virtualhost"`
+	IPs         []serverIPModel `tfsdk:"ips"`
+}
+
+// Metadata returns the data source type name.
+func (d *serverIPsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_ips"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverIPsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serverIPAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Description: "The ID of the server IP.",
+		Computed:    true,
+	}
+	attributes["server_id"] = schema.Int64Attribute{
+		Description: "The ID of the server.",
+		Computed:    true,
+	}
+	attributes["ip_address"] = schema.StringAttribute{
+		Description: "The IP address.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the IP addresses configured on the ISP Config servers.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				Description: "Only list the IP addresses of this server. Lists those of all servers by default.",
+				Optional:    true,
+			},
+			"ip_type": schema.StringAttribute{
+				Description: "Only list addresses of this IP version: 'IPv4' or 'IPv6'.",
+				Optional:    true,
+			},
+			"virtualhost": schema.BoolAttribute{
+				Description: "Only list addresses that web sites may (true) or may not (false) use.",
+				Optional:    true,
+			},
+			"ips": schema.ListNestedAttribute{
+				Description: "The IP addresses matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serverIPsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverIPsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serverIPsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipType := config.IPType.ValueString()
+	if !config.IPType.IsNull() && ipType != "IPv4" && ipType != "IPv6" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_type"),
+			"Invalid IP Type",
+			fmt.Sprintf("ip_type must be 'IPv4' or 'IPv6', got %q.", ipType),
+		)
+		return
+	}
+
+	ips, err := d.client.GetServerIPs(ctx, int(config.ServerID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading server IPs",
+			"Could not read server IPs: "+err.Error(),
+		)
+		return
+	}
+
+	config.IPs = []serverIPModel{}
+	for _, ip := range ips {
+		m := newServerIPModel(ip)
+		if (config.IPType.IsNull() || m.IPType.Equal(config.IPType)) &&
+			(config.VirtualHost.IsNull() || m.VirtualHost.Equal(config.VirtualHost)) {
+			config.IPs = append(config.IPs, m)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
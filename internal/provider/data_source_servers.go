@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serversDataSource{}
+	_ datasource.DataSourceWithConfigure = &serversDataSource{}
+)
+
+// NewServersDataSource is a helper function to simplify the provider implementation.
+func NewServersDataSource() datasource.DataSource {
+	return &serversDataSource{}
+}
+
+// serversDataSource is the data source implementation.
+type serversDataSource struct {
+	client *client.Client
+}
+
+// serversDataSourceModel maps the data source schema data.
+type serversDataSourceModel struct {
+	Role    types.String  `tfsdk:"role"`
+	Servers []serverModel `tfsdk:"servers"`
+}
+
+// Metadata returns the data source type name.
+func (d *serversDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+// Schema defines the schema for the data source.
+func (d *serversDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := serverAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Description: "The ID of the server.",
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the server.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the servers of the ISP Config installation with their roles and mirror relationships.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Description: "Only list servers with this role: one of " + strings.Join(serverRoles, ", ") + ".",
+				Optional:    true,
+			},
+			"servers": schema.ListNestedAttribute{
+				Description: "The servers matching the filter.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serversDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serversDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := config.Role.ValueString()
+	if !config.Role.IsNull() && !isServerRole(role) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Invalid Server Role",
+			fmt.Sprintf("role must be one of %s, got %q.", strings.Join(serverRoles, ", "), role),
+		)
+		return
+	}
+
+	servers, err := d.client.GetServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading servers",
+			"Could not read servers: "+err.Error(),
+		)
+		return
+	}
+
+	config.Servers = []serverModel{}
+	for _, server := range newServerModels(servers) {
+		if config.Role.IsNull() || server.hasRole(role) {
+			config.Servers = append(config.Servers, server)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// isServerRole reports whether role is a known server role.
+func isServerRole(role string) bool {
+	for _, r := range serverRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
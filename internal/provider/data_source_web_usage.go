@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &webUsageDataSource{}
+)
+
+// NewWebUsageDataSource is a helper function to simplify the provider implementation.
+func NewWebUsageDataSource() datasource.DataSource {
+	return &webUsageDataSource{}
+}
+
+// webUsageDataSource is the data source implementation.
+type webUsageDataSource struct {
+	client   *client.Client
+	clientID int
+}
+
+// webUsageDataSourceModel maps the data source schema data.
+type webUsageDataSourceModel struct {
+	ClientID types.Int64         `tfsdk:"client_id"`
+	Sites    []webUsageSiteModel `tfsdk:"sites"`
+}
+
+// webUsageSiteModel maps the usage of a single website. All sizes are in MB
+// so they can be compared with hd_quota and traffic_quota.
+type webUsageSiteModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Domain              types.String `tfsdk:"domain"`
+	HdQuota             types.Int64  `tfsdk:"hd_quota"`
+	HdUsed              types.Int64  `tfsdk:"hd_used"`
+	TrafficQuota        types.Int64  `tfsdk:"traffic_quota"`
+	TrafficThisMonth    types.Int64  `tfsdk:"traffic_this_month"`
+	TrafficLastMonth    types.Int64  `tfsdk:"traffic_last_month"`
+	TrafficThisYear     types.Int64  `tfsdk:"traffic_this_year"`
+	TrafficLastYear     types.Int64  `tfsdk:"traffic_last_year"`
+	FTPTrafficThisMonth types.Int64  `tfsdk:"ftp_traffic_this_month"`
+	FTPTrafficLastMonth types.Int64  `tfsdk:"ftp_traffic_last_month"`
+	FTPTrafficThisYear  types.Int64  `tfsdk:"ftp_traffic_this_year"`
+	FTPTrafficLastYear  types.Int64  `tfsdk:"ftp_traffic_last_year"`
+}
+
+// Metadata returns the data source type name.
+func (d *webUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_usage"
+}
+
+// Schema defines the schema for the data source.
+func (d *webUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	mb := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description + " in MB.",
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reports the disk and traffic usage of a client's websites in ISP Config. " +
+			"All values are in MB, matching hd_quota and traffic_quota of ispconfig_web_hosting.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.Int64Attribute{
+				Description: "The ISP Config client ID. Defaults to the provider client_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sites": schema.ListNestedAttribute{
+				Description: "The usage per website, ordered by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The ID of the web hosting domain.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "The domain name.",
+							Computed:    true,
+						},
+						"hd_quota":               mb("The disk quota (-1 for unlimited)"),
+						"hd_used":                mb("The disk space used, as last reported by the server's quota monitor,"),
+						"traffic_quota":          mb("The monthly traffic quota (-1 for unlimited)"),
+						"traffic_this_month":     mb("The HTTP traffic of the current month"),
+						"traffic_last_month":     mb("The HTTP traffic of the previous month"),
+						"traffic_this_year":      mb("The HTTP traffic of the current year"),
+						"traffic_last_year":      mb("The HTTP traffic of the previous year"),
+						"ftp_traffic_this_month": mb("The FTP traffic of the current month"),
+						"ftp_traffic_last_month": mb("The FTP traffic of the previous month"),
+						"ftp_traffic_this_year":  mb("The FTP traffic of the current year"),
+						"ftp_traffic_last_year":  mb("The FTP traffic of the previous year"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.clientID = providerData.ClientID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config webUsageDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Determine client ID
+	clientID := d.clientID
+	if !config.ClientID.IsNull() {
+		clientID = int(config.ClientID.ValueInt64())
+	}
+
+	if clientID == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Client ID",
+			"Client ID must be set either in the provider configuration or in the data source configuration.",
+		)
+		return
+	}
+
+	quotas, err := d.client.GetWebQuotas(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading disk usage",
+			fmt.Sprintf("Could not read disk usage of client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	traffic, err := d.client.GetWebTraffic(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading traffic",
+			fmt.Sprintf("Could not read traffic of client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	ftpTraffic, err := d.client.GetFTPTraffic(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading FTP traffic",
+			fmt.Sprintf("Could not read FTP traffic of client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	config.ClientID = types.Int64Value(int64(clientID))
+	config.Sites = mergeWebUsage(quotas, traffic, ftpTraffic)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// mergeWebUsage combines the disk, HTTP traffic and FTP traffic records of
+// the remote API into one entry per website, ordered by ID. The API reports
+// disk usage in KB and traffic in bytes; both are converted to MB.
+func mergeWebUsage(quotas []client.WebQuota, traffic, ftpTraffic []client.WebTraffic) []webUsageSiteModel {
+	const kbPerMB, bytesPerMB = 1024, 1024 * 1024
+
+	sites := map[int]*webUsageSiteModel{}
+	site := func(id client.FlexInt, domain string) *webUsageSiteModel {
+		s, ok := sites[int(id)]
+		if !ok {
+			zero := types.Int64Value(0)
+			s = &webUsageSiteModel{
+				ID:                  types.Int64Value(int64(id)),
+				Domain:              types.StringValue(domain),
+				HdQuota:             zero,
+				HdUsed:              zero,
+				TrafficQuota:        zero,
+				TrafficThisMonth:    zero,
+				TrafficLastMonth:    zero,
+				TrafficThisYear:     zero,
+				TrafficLastYear:     zero,
+				FTPTrafficThisMonth: zero,
+				FTPTrafficLastMonth: zero,
+				FTPTrafficThisYear:  zero,
+				FTPTrafficLastYear:  zero,
+			}
+			sites[int(id)] = s
+		}
+		return s
+	}
+
+	for _, q := range quotas {
+		s := site(q.DomainID, q.Domain)
+		s.HdQuota = types.Int64Value(int64(q.HdQuota))
+		s.HdUsed = types.Int64Value(int64(q.Used) / kbPerMB)
+	}
+	for _, t := range traffic {
+		s := site(t.DomainID, t.Domain)
+		s.TrafficQuota = types.Int64Value(int64(t.TrafficQuota))
+		s.TrafficThisMonth = types.Int64Value(int64(t.ThisMonth) / bytesPerMB)
+		s.TrafficLastMonth = types.Int64Value(int64(t.LastMonth) / bytesPerMB)
+		s.TrafficThisYear = types.Int64Value(int64(t.ThisYear) / bytesPerMB)
+		s.TrafficLastYear = types.Int64Value(int64(t.LastYear) / bytesPerMB)
+	}
+	for _, t := range ftpTraffic {
+		s := site(t.DomainID, t.Domain)
+		s.FTPTrafficThisMonth = types.Int64Value(int64(t.ThisMonth) / bytesPerMB)
+		s.FTPTrafficLastMonth = types.Int64Value(int64(t.LastMonth) / bytesPerMB)
+		s.FTPTrafficThisYear = types.Int64Value(int64(t.ThisYear) / bytesPerMB)
+		s.FTPTrafficLastYear = types.Int64Value(int64(t.LastYear) / bytesPerMB)
+	}
+
+	result := make([]webUsageSiteModel, 0, len(sites))
+	for _, s := range sites {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID.ValueInt64() < result[j].ID.ValueInt64()
+	})
+	return result
+}
@@ -1,10 +1,31 @@
 package provider
 
 import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"net"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
 )
 
+// apiDateTimeLayout is the datetime format used by ISPConfig's MySQL columns.
+const apiDateTimeLayout = "2006-01-02 15:04:05"
+
 // boolToYN converts a Go bool to the "y"/"n" string expected by the ISPConfig API.
 func boolToYN(b bool) string {
 	if b {
@@ -18,6 +39,15 @@ func ynToBool(s string) bool {
 	return s == "y" || s == "Y"
 }
 
+// optionalString converts an API string for an Optional attribute, keeping
+// a null value null when the API returns an empty string.
+func optionalString(current types.String, value string) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
 // mbToAPIQuota converts a mailbox quota from MB (as provided by the user) to
 // bytes, as expected by the ISPConfig API.
 // Special values -1 (unlimited) and 0 (no mail) are passed through unchanged.
@@ -51,3 +81,455 @@ func parseCronSchedule(schedule string) (runMin, runHour, runMday, runMonth, run
 func buildCronSchedule(runMin, runHour, runMday, runMonth, runWday string) string {
 	return strings.Join([]string{runMin, runHour, runMday, runMonth, runWday}, " ")
 }
+
+// rfc3339ToAPIDateTime converts an RFC 3339 timestamp (as used in Terraform,
+// e.g. by timeadd()) to the datetime format expected by the ISPConfig API.
+// ISPConfig compares the value with the server's local time and stores no
+// zone, so the wall-clock time is sent unchanged and the offset is dropped.
+func rfc3339ToAPIDateTime(value string) (string, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", fmt.Errorf("must be an RFC 3339 timestamp (e.g. 2026-12-31T23:59:59Z): %w", err)
+	}
+	return t.Format(apiDateTimeLayout), nil
+}
+
+// apiDateTimeToRFC3339 converts an ISPConfig datetime back to RFC 3339. The
+// API does not return the server's zone, so the wall-clock time gets a Z
+// offset. Empty and zero dates yield an empty string.
+func apiDateTimeToRFC3339(value string) string {
+	if value == "" || strings.HasPrefix(value, "0000-00-00") {
+		return ""
+	}
+	t, err := time.Parse(apiDateTimeLayout, value)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// defaultWebDAVUserPrefix is ISPConfig's default webdavuser_prefix setting.
+const defaultWebDAVUserPrefix = "[CLIENTNAME]"
+
+// replaceUsernamePrefix resolves the placeholders ISPConfig supports in its
+// username prefix settings ([CLIENTNAME], [CLIENTID], [DOMAINID]), mirroring
+// tools_sites::replacePrefix.
+func replaceUsernamePrefix(pattern, clientUsername string, clientID, domainID int) string {
+	if pattern == "" {
+		return ""
+	}
+	clientName := convertClientName(clientUsername)
+	if clientName == "" {
+		clientName = "default"
+	}
+	r := strings.NewReplacer(
+		"[CLIENTNAME]", clientName,
+		"[CLIENTID]", fmt.Sprintf("%d", clientID),
+		"[DOMAINID]", fmt.Sprintf("%d", domainID),
+	)
+	return r.Replace(pattern)
+}
+
+// convertClientName normalizes a client username for use in a prefix the way
+// ISPConfig's tools_sites::convertClientName does: lowercase, spaces dropped
+// and any character outside [a-z0-9_] replaced by an underscore.
+func convertClientName(name string) string {
+	var b strings.Builder
+	for _, ch := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case ch == ' ':
+			continue
+		case (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '_':
+			b.WriteRune(ch)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// parseCertificatePEM decodes the first CERTIFICATE block of a PEM string,
+// as stored by ISPConfig in web_domain.ssl_cert.
+func parseCertificatePEM(certPEM string) (*x509.Certificate, error) {
+	rest := []byte(certPEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM encoded certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// certificateInfo returns the expiry (RFC 3339, UTC) and the SHA-256
+// fingerprint (uppercase, colon separated, as printed by
+// `openssl x509 -fingerprint -sha256`) of a PEM encoded certificate.
+func certificateInfo(certPEM string) (expiry string, fingerprint string, err error) {
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		return "", "", err
+	}
+
+	sum := sha256.Sum256(cert.Raw)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return cert.NotAfter.UTC().Format(time.RFC3339), strings.Join(parts, ":"), nil
+}
+
+// parsePrivateKeyPEM decodes the first private key block of a PEM string.
+// PKCS#8, PKCS#1 (RSA) and SEC 1 (EC) encodings are supported; other blocks
+// such as "EC PARAMETERS" are skipped.
+func parsePrivateKeyPEM(keyPEM string) (crypto.Signer, error) {
+	rest := []byte(keyPEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM encoded private key found")
+		}
+
+		var key interface{}
+		var err error
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		case "ENCRYPTED PRIVATE KEY":
+			return nil, fmt.Errorf("encrypted private keys are not supported")
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+}
+
+// validateCertificateKeyPair checks that certPEM holds a certificate whose
+// public key belongs to the private key in keyPEM, and that chainPEM (if not
+// empty) only holds parseable certificates.
+func validateCertificateKeyPair(certPEM, keyPEM, chainPEM string) error {
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		return fmt.Errorf("invalid certificate: %w", err)
+	}
+
+	key, err := parsePrivateKeyPEM(keyPEM)
+	if err != nil {
+		return fmt.Errorf("invalid private key: %w", err)
+	}
+
+	pub, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(key.Public()) {
+		return fmt.Errorf("private key does not match the certificate (subject %q)", cert.Subject.CommonName)
+	}
+
+	if strings.TrimSpace(chainPEM) == "" {
+		return nil
+	}
+	rest := []byte(chainPEM)
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("invalid chain: unexpected PEM block %q", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("invalid chain: %w", err)
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("invalid chain: no PEM encoded certificate found")
+	}
+	return nil
+}
+
+// renderPHPIni renders php.ini settings into the "key = value" lines stored
+// in ISPConfig's custom_php_ini field. Keys are sorted for a stable result.
+func renderPHPIni(settings map[string]string) string {
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s = %s\n", k, settings[k])
+	}
+	return b.String()
+}
+
+// parsePHPIni parses ISPConfig's custom_php_ini field. Blank lines, comments
+// and section headers are skipped.
+func parsePHPIni(ini string) map[string]string {
+	settings := map[string]string{}
+	for _, line := range strings.Split(ini, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		settings[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return settings
+}
+
+// validatePHPIniSettings checks that custom_php_ini entries can be rendered
+// into single "key = value" lines.
+func validatePHPIniSettings(settings map[string]string) error {
+	for k, v := range settings {
+		if strings.TrimSpace(k) == "" || strings.ContainsAny(k, "=;[]\r\n") || strings.TrimSpace(k) != k {
+			return fmt.Errorf("invalid php.ini key %q", k)
+		}
+		if strings.ContainsAny(v, "\r\n") {
+			return fmt.Errorf("php.ini value of %q must not contain line breaks", k)
+		}
+	}
+	return nil
+}
+
+// fpmPoolSettings holds the PHP-FPM pool settings of a web domain. Zero
+// means the value is not set (or not known yet) and is not checked; values
+// below 1 are rejected by the caller before.
+type fpmPoolSettings struct {
+	PM              string
+	MaxChildren     int64
+	StartServers    int64
+	MinSpareServers int64
+	MaxSpareServers int64
+}
+
+// validate enforces the invariants php-fpm (and ISPConfig's web domain form)
+// apply to a pool. The spare and start server settings only matter for the
+// dynamic process manager.
+func (s fpmPoolSettings) validate() []string {
+	if s.PM != "dynamic" {
+		return nil
+	}
+
+	var problems []string
+	if s.MinSpareServers > 0 && s.MaxSpareServers > 0 && s.MinSpareServers > s.MaxSpareServers {
+		problems = append(problems, fmt.Sprintf("pm_min_spare_servers (%d) must not be greater than pm_max_spare_servers (%d)", s.MinSpareServers, s.MaxSpareServers))
+	}
+	if s.StartServers > 0 && s.MinSpareServers > 0 && s.StartServers < s.MinSpareServers {
+		problems = append(problems, fmt.Sprintf("pm_start_servers (%d) must not be less than pm_min_spare_servers (%d)", s.StartServers, s.MinSpareServers))
+	}
+	if s.StartServers > 0 && s.MaxSpareServers > 0 && s.StartServers > s.MaxSpareServers {
+		problems = append(problems, fmt.Sprintf("pm_start_servers (%d) must not be greater than pm_max_spare_servers (%d)", s.StartServers, s.MaxSpareServers))
+	}
+	if s.MaxChildren > 0 && s.MaxSpareServers > s.MaxChildren {
+		problems = append(problems, fmt.Sprintf("pm_max_spare_servers (%d) must not be greater than pm_max_children (%d)", s.MaxSpareServers, s.MaxChildren))
+	}
+	return problems
+}
+
+// backupIntervals lists the backup_interval values ISPConfig accepts for
+// websites and databases.
+var backupIntervals = []string{"none", "daily", "weekly", "monthly"}
+
+// maxBackupCopies is the highest backup_copies value ISPConfig offers.
+const maxBackupCopies = 30
+
+// validateBackupInterval checks a backup_interval value.
+func validateBackupInterval(interval string) error {
+	for _, v := range backupIntervals {
+		if interval == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("backup_interval must be one of %s (got %q)", strings.Join(backupIntervals, ", "), interval)
+}
+
+// validateBackupCopies checks a backup_copies value.
+func validateBackupCopies(copies int64) error {
+	if copies < 1 || copies > maxBackupCopies {
+		return fmt.Errorf("backup_copies must be between 1 and %d (got %d)", maxBackupCopies, copies)
+	}
+	return nil
+}
+
+// statsTypes lists the web statistics programs ISPConfig can run for a
+// website.
+var statsTypes = []string{"awstats", "goaccess", "webalizer"}
+
+// validateStatsType checks a stats_type value.
+func validateStatsType(statsType string) error {
+	for _, v := range statsTypes {
+		if statsType == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("stats_type must be one of %s (got %q)", strings.Join(statsTypes, ", "), statsType)
+}
+
+// validateBackupConfig validates the backup_interval and backup_copies
+// attributes shared by the web hosting and database resources at plan time.
+// Unknown values are skipped.
+func validateBackupConfig(interval types.String, copies types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !interval.IsNull() && !interval.IsUnknown() {
+		if err := validateBackupInterval(interval.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("backup_interval"), "Invalid Backup Interval", err.Error())
+		}
+	}
+	if !copies.IsNull() && !copies.IsUnknown() {
+		if err := validateBackupCopies(copies.ValueInt64()); err != nil {
+			diags.AddAttributeError(path.Root("backup_copies"), "Invalid Backup Copies", err.Error())
+		}
+	}
+	return diags
+}
+
+// backupSettings returns the backup_interval and backup_copies to send to
+// ISPConfig. Unset (null or unknown) values are left empty so that they are
+// omitted from the request and a schedule configured in the panel is kept.
+func backupSettings(interval types.String, copies types.Int64) (string, client.FlexInt) {
+	var i string
+	var c client.FlexInt
+	if !interval.IsNull() && !interval.IsUnknown() {
+		i = interval.ValueString()
+	}
+	if !copies.IsNull() && !copies.IsUnknown() {
+		c = client.FlexInt(copies.ValueInt64())
+	}
+	return i, c
+}
+
+// phpHandlers lists the PHP handlers whose versions ISPConfig reports per
+// server (server_get_php_versions).
+var phpHandlers = []string{"php-fpm", "fast-cgi"}
+
+// comparePHPVersions compares two PHP version strings numerically, component
+// by component, so that "8.10" sorts after "8.4". It returns -1, 0 or 1.
+// Non-numeric components are compared as strings.
+func comparePHPVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil && an != bn:
+			if an < bn {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && as[i] != bs[i]:
+			return strings.Compare(as[i], bs[i])
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
+
+// serverIPType returns the ISPConfig ip_type of an address: "IPv4" or "IPv6".
+func serverIPType(address string) (string, error) {
+	ip := net.ParseIP(address)
+	switch {
+	case ip == nil:
+		return "", fmt.Errorf("%q is not a valid IP address", address)
+	case ip.To4() != nil:
+		return "IPv4", nil
+	}
+	return "IPv6", nil
+}
+
+// checkServerIP checks that a web site address of the given ip_type is
+// configured on the server and enabled for virtual hosts, as ISPConfig only
+// offers those IPs in its web domain form. An empty address and the "*"
+// wildcard (all IPv4 addresses) are always accepted.
+func checkServerIP(ips []client.ServerIP, address, ipType string) error {
+	if address == "" || address == "*" {
+		return nil
+	}
+	if t, err := serverIPType(address); err != nil {
+		return err
+	} else if t != ipType {
+		return fmt.Errorf("%s is not an %s address", address, ipType)
+	}
+
+	available := []string{}
+	for _, ip := range ips {
+		if ip.IPType != ipType || !ynToBool(ip.VirtualHost) {
+			continue
+		}
+		if ip.IPAddress == address {
+			return nil
+		}
+		available = append(available, ip.IPAddress)
+	}
+	if len(available) == 0 {
+		return fmt.Errorf("%s is not configured on the server, which has no %s addresses enabled for web sites", address, ipType)
+	}
+	return fmt.Errorf("%s is not configured on the server for web sites; available %s addresses: %s", address, ipType, strings.Join(available, ", "))
+}
+
+// validatePortList checks a comma-separated list of TCP ports such as
+// "80,443".
+func validatePortList(ports string) error {
+	for _, port := range strings.Split(ports, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(port))
+		if err != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("%q is not a comma-separated list of ports (1-65535), e.g. \"80,443\"", ports)
+		}
+	}
+	return nil
+}
+
+// defaultTimeout bounds create, update and delete unless the timeouts block
+// of the resource overrides it.
+const defaultTimeout = 20 * time.Minute
+
+// timeoutsBlock returns the timeouts block of the resources.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true})
+}
+
+// nullTimeouts returns an unset timeouts block for state that is built from
+// scratch, such as by state upgraders. The zero timeouts.Value lacks the
+// attribute types and cannot be stored.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// withTimeout bounds ctx by the configured timeout of an operation, e.g.
+// plan.Timeouts.Create, so that it reaches every API request.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+	return context.WithTimeout(ctx, d)
+}
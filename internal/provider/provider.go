@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,7 +23,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &ISPConfigProvider{}
+	_ provider.Provider            = &ISPConfigProvider{}
+	_ provider.ProviderWithActions = &ISPConfigProvider{}
 )
 
 // ISPConfigProvider is the provider implementation.
@@ -31,12 +37,24 @@ type ISPConfigProvider struct {
 
 // ISPConfigProviderModel describes the provider data model.
 type ISPConfigProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Insecure types.Bool   `tfsdk:"insecure"`
-	ClientID types.Int64  `tfsdk:"client_id"`
-	ServerID types.Int64  `tfsdk:"server_id"`
+	Host       types.String `tfsdk:"host"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	ProxyURL   types.String `tfsdk:"proxy_url"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Insecure   types.Bool   `tfsdk:"insecure"`
+	ClientID   types.Int64  `tfsdk:"client_id"`
+	ServerID   types.Int64  `tfsdk:"server_id"`
+	ResellerID types.Int64  `tfsdk:"reseller_id"`
+
+	CACertPEM  types.String `tfsdk:"ca_cert_pem"`
+	CACertFile types.String `tfsdk:"ca_cert_file"`
+	ClientCert types.String `tfsdk:"client_cert"`
+	ClientKey  types.String `tfsdk:"client_key"`
+
+	WaitForJobqueue types.Bool   `tfsdk:"wait_for_jobqueue"`
+	JobqueueTimeout types.String `tfsdk:"jobqueue_timeout"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
 }
 
 // Metadata returns the provider type name.
@@ -51,8 +69,19 @@ func (p *ISPConfigProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 		Description: "Interact with ISP Config API to manage web hosting resources.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Description: "The ISP Config host and port (e.g., 'your-server.com:8080'). " +
-					"Can also be set via the ISPCONFIG_HOST environment variable.",
+				Description: "The ISP Config host and port (e.g., 'your-server.com:8080'); the API is reached at https://<host>/remote/json.php. " +
+					"Either host or endpoint must be set. Can also be set via the ISPCONFIG_HOST environment variable.",
+				Optional: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The full URL of the remote API, as an alternative to host, e.g. 'https://panel.example.com/ispconfig/remote/json.php' " +
+					"behind a reverse proxy or 'http://10.0.0.5:8080' inside a VPN. If the URL does not end in a PHP script, /remote/json.php is appended. " +
+					"Conflicts with host. Can also be set via the ISPCONFIG_ENDPOINT environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "The URL of an HTTP(S) or SOCKS5 proxy to send API requests through (e.g. 'http://proxy.example.com:3128'). " +
+					"Can also be set via the ISPCONFIG_PROXY_URL environment variable.",
 				Optional: true,
 			},
 			"username": schema.StringAttribute{
@@ -71,6 +100,27 @@ func (p *ISPConfigProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 					"Can also be set via the ISPCONFIG_INSECURE environment variable.",
 				Optional: true,
 			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust in addition to the system roots, e.g. an internal CA that issued the panel certificate. " +
+					"Conflicts with ca_cert_file. Can also be set via the ISPCONFIG_CA_CERT_PEM environment variable.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM file with CA certificates to trust in addition to the system roots. " +
+					"Conflicts with ca_cert_pem. Can also be set via the ISPCONFIG_CA_CERT_FILE environment variable.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to servers that require mutual TLS. Requires client_key. " +
+					"Can also be set via the ISPCONFIG_CLIENT_CERT environment variable.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of client_cert. " +
+					"Can also be set via the ISPCONFIG_CLIENT_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
 		"client_id": schema.Int64Attribute{
 			Description: "The default ISP Config client ID to use for resources. " +
 				"Can also be set via the ISPCONFIG_CLIENT_ID environment variable.",
@@ -81,6 +131,30 @@ func (p *ISPConfigProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				"Can also be set via the ISPCONFIG_SERVER_ID environment variable.",
 			Optional: true,
 		},
+		"reseller_id": schema.Int64Attribute{
+			Description: "The client ID of the reseller the provider acts for. When set, resources refuse to act on a client_id " +
+				"that is not the reseller itself or one of its clients. " +
+				"Can also be set via the ISPCONFIG_RESELLER_ID environment variable.",
+			Optional: true,
+		},
+		"wait_for_jobqueue": schema.BoolAttribute{
+			Description: "Whether resources wait after each change until their server has applied all pending changes " +
+				"(monitor_jobqueue_count), so that e.g. a vhost exists when the apply finishes. Defaults to false. " +
+				"Can also be set via the ISPCONFIG_WAIT_FOR_JOBQUEUE environment variable.",
+			Optional: true,
+		},
+		"jobqueue_timeout": schema.StringAttribute{
+			Description: "How long to wait for the job queue when wait_for_jobqueue is enabled, as a duration (e.g. '90s', '5m'). " +
+				"Defaults to '5m'. On timeout the apply fails; a created resource is kept in the state as tainted. " +
+				"Can also be set via the ISPCONFIG_JOBQUEUE_TIMEOUT environment variable.",
+			Optional: true,
+		},
+		"request_timeout": schema.StringAttribute{
+			Description: "How long a single API request may take, as a duration (e.g. '30s', '2m'). Defaults to '30s'. " +
+				"Requests of resources also end when their create, update or delete timeout (timeouts block) is reached. " +
+				"Can also be set via the ISPCONFIG_REQUEST_TIMEOUT environment variable.",
+			Optional: true,
+		},
 	},
 }
 }
@@ -134,16 +208,34 @@ func (p *ISPConfigProvider) Configure(ctx context.Context, req provider.Configur
 	// with Terraform configuration value if set.
 
 	host := os.Getenv("ISPCONFIG_HOST")
+	endpoint := os.Getenv("ISPCONFIG_ENDPOINT")
+	proxyURL := os.Getenv("ISPCONFIG_PROXY_URL")
 	username := os.Getenv("ISPCONFIG_USERNAME")
 	password := os.Getenv("ISPCONFIG_PASSWORD")
 	insecure := os.Getenv("ISPCONFIG_INSECURE") == "true"
 	clientID := int64(0)
 	serverID := int64(0)
+	resellerID := int64(0)
+	waitForJobqueue := os.Getenv("ISPCONFIG_WAIT_FOR_JOBQUEUE") == "true"
+	jobqueueTimeout := os.Getenv("ISPCONFIG_JOBQUEUE_TIMEOUT")
+	requestTimeout := os.Getenv("ISPCONFIG_REQUEST_TIMEOUT")
+	caCertPEM := os.Getenv("ISPCONFIG_CA_CERT_PEM")
+	caCertFile := os.Getenv("ISPCONFIG_CA_CERT_FILE")
+	clientCert := os.Getenv("ISPCONFIG_CLIENT_CERT")
+	clientKey := os.Getenv("ISPCONFIG_CLIENT_KEY")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
+	if !config.Endpoint.IsNull() {
+		endpoint = config.Endpoint.ValueString()
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+
 	if !config.Username.IsNull() {
 		username = config.Username.ValueString()
 	}
@@ -171,15 +263,121 @@ func (p *ISPConfigProvider) Configure(ctx context.Context, req provider.Configur
 		serverID = config.ServerID.ValueInt64()
 	}
 
+	// Check environment variable for reseller_id
+	if envResellerID := os.Getenv("ISPCONFIG_RESELLER_ID"); envResellerID != "" {
+		if parsed, err := strconv.ParseInt(envResellerID, 10, 64); err == nil {
+			resellerID = parsed
+		}
+	}
+
+	if !config.ResellerID.IsNull() {
+		resellerID = config.ResellerID.ValueInt64()
+	}
+
+	if !config.WaitForJobqueue.IsNull() {
+		waitForJobqueue = config.WaitForJobqueue.ValueBool()
+	}
+
+	if !config.JobqueueTimeout.IsNull() {
+		jobqueueTimeout = config.JobqueueTimeout.ValueString()
+	}
+	if jobqueueTimeout == "" {
+		jobqueueTimeout = "5m"
+	}
+	jobqueueWait, err := time.ParseDuration(jobqueueTimeout)
+	if err != nil || jobqueueWait <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("jobqueue_timeout"),
+			"Invalid Job Queue Timeout",
+			fmt.Sprintf("jobqueue_timeout must be a positive duration such as '90s' or '5m', got %q.", jobqueueTimeout),
+		)
+	}
+
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = config.RequestTimeout.ValueString()
+	}
+	var requestWait time.Duration
+	if requestTimeout != "" {
+		requestWait, err = time.ParseDuration(requestTimeout)
+		if err != nil || requestWait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as '30s' or '2m', got %q.", requestTimeout),
+			)
+		}
+	}
+
+	if !config.CACertPEM.IsNull() {
+		caCertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.ClientCert.IsNull() {
+		clientCert = config.ClientCert.ValueString()
+	}
+
+	if !config.ClientKey.IsNull() {
+		clientKey = config.ClientKey.ValueString()
+	}
+
+	caCertPath := path.Root("ca_cert_pem")
+	if caCertFile != "" {
+		caCertPath = path.Root("ca_cert_file")
+		if caCertPEM != "" {
+			resp.Diagnostics.AddAttributeError(
+				caCertPath,
+				"Conflicting CA Certificates",
+				"Only one of ca_cert_pem and ca_cert_file (or ISPCONFIG_CA_CERT_PEM and ISPCONFIG_CA_CERT_FILE) may be set.",
+			)
+		} else if data, err := os.ReadFile(caCertFile); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				caCertPath,
+				"Unable to Read CA Certificate File",
+				fmt.Sprintf("Could not read %s: %s", caCertFile, err.Error()),
+			)
+		} else {
+			caCertPEM = string(data)
+		}
+	}
+
+	if (clientCert == "") != (clientKey == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cert"),
+			"Incomplete Client Certificate",
+			"client_cert and client_key (or ISPCONFIG_CLIENT_CERT and ISPCONFIG_CLIENT_KEY) must be set together.",
+		)
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if host == "" {
+	// A host or endpoint in the configuration takes precedence over the
+	// environment variable of the other.
+	if !config.Endpoint.IsNull() && config.Host.IsNull() {
+		host = ""
+	}
+	if !config.Host.IsNull() && config.Endpoint.IsNull() {
+		endpoint = ""
+	}
+
+	if host != "" && endpoint != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Conflicting ISP Config Endpoint",
+			"Only one of host and endpoint (or ISPCONFIG_HOST and ISPCONFIG_ENDPOINT) may be set.",
+		)
+	}
+
+	if host == "" && endpoint == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing ISP Config Host",
 			"The provider cannot create the ISP Config API client as there is a missing or empty value for the ISP Config host. "+
-				"Set the host value in the configuration or use the ISPCONFIG_HOST environment variable. "+
+				"Set the host or endpoint value in the configuration or use the ISPCONFIG_HOST or ISPCONFIG_ENDPOINT environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -209,19 +407,53 @@ func (p *ISPConfigProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	ctx = tflog.SetField(ctx, "ispconfig_host", host)
+	ctx = tflog.SetField(ctx, "ispconfig_endpoint", endpoint)
 	ctx = tflog.SetField(ctx, "ispconfig_username", username)
 	ctx = tflog.SetField(ctx, "ispconfig_password", "***")
 	ctx = tflog.SetField(ctx, "ispconfig_insecure", insecure)
 	ctx = tflog.SetField(ctx, "ispconfig_client_id", clientID)
 	ctx = tflog.SetField(ctx, "ispconfig_server_id", serverID)
+	ctx = tflog.SetField(ctx, "ispconfig_reseller_id", resellerID)
+	ctx = tflog.SetField(ctx, "ispconfig_wait_for_jobqueue", waitForJobqueue)
+	ctx = tflog.SetField(ctx, "ispconfig_request_timeout", requestTimeout)
 
 	tflog.Debug(ctx, "Creating ISP Config client")
 
 	// Create a new ISP Config client using the configuration values
 	apiClient := client.NewClient(host, username, password, insecure)
+	if requestWait > 0 {
+		apiClient.SetRequestTimeout(requestWait)
+	}
+	if endpoint != "" {
+		if err := apiClient.SetEndpoint(endpoint); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("endpoint"), "Invalid ISP Config Endpoint", err.Error())
+			return
+		}
+	}
+	if proxyURL != "" {
+		if err := apiClient.SetProxy(proxyURL); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", err.Error())
+			return
+		}
+	}
+	if caCertPEM != "" {
+		if err := apiClient.SetCACertificates([]byte(caCertPEM)); err != nil {
+			resp.Diagnostics.AddAttributeError(caCertPath, "Invalid CA Certificate", err.Error())
+			return
+		}
+	}
+	if clientCert != "" {
+		if err := apiClient.SetClientCertificate([]byte(clientCert), []byte(clientKey)); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("client_cert"), "Invalid Client Certificate", err.Error())
+			return
+		}
+	}
+	if waitForJobqueue {
+		apiClient.SetJobqueueWait(jobqueueWait)
+	}
 
 	// Login to establish session
-	err := apiClient.Login()
+	err = apiClient.Login()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Login to ISP Config API",
@@ -234,29 +466,270 @@ func (p *ISPConfigProvider) Configure(ctx context.Context, req provider.Configur
 
 	tflog.Info(ctx, "ISP Config client configured successfully")
 
-	// Store client, client_id, and server_id in provider data for use in resources and data sources
+	// Store client, client_id, server_id and reseller_id in provider data for use in resources and data sources.
+	// The PHP version and owned client caches are shared by all resources.
 	providerData := &ISPConfigProviderData{
-		Client:   apiClient,
-		ClientID: int(clientID),
-		ServerID: int(serverID),
+		Client:       apiClient,
+		ClientID:     int(clientID),
+		ServerID:     int(serverID),
+		ResellerID:   int(resellerID),
+		PHPVersions:  newPHPVersionCache(),
+		OwnedClients: newOwnedClientCache(),
 	}
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.ActionData = providerData
 }
 
 // ISPConfigProviderData contains the shared client for resources and data sources
 type ISPConfigProviderData struct {
-	Client   *client.Client
-	ClientID int
-	ServerID int
+	Client       *client.Client
+	ClientID     int
+	ServerID     int
+	ResellerID   int
+	PHPVersions  *phpVersionCache
+	OwnedClients *ownedClientCache
+}
+
+// waitForJobqueue waits until the server of a resource has applied its
+// changes, if the provider is configured to. Without a known server ID all
+// servers are waited for. Callers save the state first: a timeout is an
+// error, so a created resource is tainted rather than lost.
+func waitForJobqueue(ctx context.Context, c *client.Client, serverID types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := c.WaitForJobqueue(ctx, int(serverID.ValueInt64())); err != nil {
+		diags.AddError(
+			"Changes Not Yet Applied",
+			"The change was saved in ISP Config, but the server has not applied it yet: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// checkClientOwnership verifies that clientID is the reseller or one of its
+// clients. It does nothing when the provider does not act for a reseller.
+func checkClientOwnership(ctx context.Context, c *client.Client, resellerID, clientID int) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if resellerID == 0 || clientID == resellerID {
+		return diags
+	}
+	if clientID == 0 {
+		diags.AddAttributeError(
+			path.Root("client_id"),
+			"Client Not Owned by Reseller",
+			fmt.Sprintf("No client ID is set, so the resource would not belong to reseller ID %d configured in the provider. "+
+				"Set client_id to the reseller or one of its clients.", resellerID),
+		)
+		return diags
+	}
+
+	ispClient, err := c.GetClient(ctx, clientID)
+	if err != nil {
+		diags.AddError(
+			"Error checking client ownership",
+			fmt.Sprintf("Could not read client ID %d: %s", clientID, err.Error()),
+		)
+		return diags
+	}
+
+	if int(ispClient.ParentClientID) != resellerID {
+		diags.AddAttributeError(
+			path.Root("client_id"),
+			"Client Not Owned by Reseller",
+			fmt.Sprintf("Client ID %d does not belong to reseller ID %d configured in the provider.", clientID, resellerID),
+		)
+	}
+	return diags
+}
+
+// ownedClientCache remembers the clients that passed checkClientOwnership.
+// One cache is shared by the whole provider, so that plan-time checks and
+// all resource instances read each client only once.
+type ownedClientCache struct {
+	mu      sync.Mutex
+	clients map[int]bool
+}
+
+// newOwnedClientCache returns an empty cache.
+func newOwnedClientCache() *ownedClientCache {
+	return &ownedClientCache{clients: map[int]bool{}}
+}
+
+// check runs checkClientOwnership unless clientID already passed it. Failed
+// checks are not cached. A nil cache always checks.
+func (o *ownedClientCache) check(ctx context.Context, c *client.Client, resellerID, clientID int) diag.Diagnostics {
+	if o == nil {
+		return checkClientOwnership(ctx, c, resellerID, clientID)
+	}
+
+	o.mu.Lock()
+	owned := o.clients[clientID]
+	o.mu.Unlock()
+	if owned {
+		return nil
+	}
+
+	diags := checkClientOwnership(ctx, c, resellerID, clientID)
+	if !diags.HasError() {
+		o.mu.Lock()
+		o.clients[clientID] = true
+		o.mu.Unlock()
+	}
+	return diags
+}
+
+// clientScope holds the provider settings of the resources that belong to a
+// client. Resources embed it for the reseller ownership checks.
+type clientScope struct {
+	client       *client.Client
+	clientID     int
+	serverID     int
+	resellerID   int
+	ownedClients *ownedClientCache
+}
+
+// configure copies the provider settings into the scope.
+func (s *clientScope) configure(providerData *ISPConfigProviderData) {
+	s.client = providerData.Client
+	s.clientID = providerData.ClientID
+	s.serverID = providerData.ServerID
+	s.resellerID = providerData.ResellerID
+	s.ownedClients = providerData.OwnedClients
+}
+
+// ModifyPlan checks a known client_id, or the provider client_id it falls
+// back to, against the reseller, so that a foreign client fails the plan.
+func (s *clientScope) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if s.client == nil || s.resellerID == 0 || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planClientID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("client_id"), &planClientID)...)
+	if resp.Diagnostics.HasError() || planClientID.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(s.ownedClients.check(ctx, s.client, s.resellerID, s.resolveClientID(planClientID))...)
+}
+
+// resolveClientID returns the configured client ID, or the provider client_id
+// if none is set.
+func (s *clientScope) resolveClientID(clientID types.Int64) int {
+	if !clientID.IsNull() && !clientID.IsUnknown() {
+		return int(clientID.ValueInt64())
+	}
+	return s.clientID
+}
+
+// startOperation bounds ctx by the operation timeout, resolves the client of
+// the resource and checks it against the reseller. Create and Update set
+// requireClient, as ISP Config needs a client to assign new records to.
+func (s *clientScope) startOperation(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), clientID types.Int64, requireClient bool, diags *diag.Diagnostics) (context.Context, context.CancelFunc, int) {
+	ctx, cancel := withTimeout(ctx, timeout, diags)
+	if diags.HasError() {
+		return ctx, cancel, 0
+	}
+
+	id := s.resolveClientID(clientID)
+	if id == 0 && requireClient {
+		diags.AddError(
+			"Missing Client ID",
+			"Client ID must be set either in the provider configuration or in the resource configuration.",
+		)
+		return ctx, cancel, 0
+	}
+	diags.Append(s.ownedClients.check(ctx, s.client, s.resellerID, id)...)
+	return ctx, cancel, id
+}
+
+// clientLimits maps the client limits checked at plan time to the client
+// field ISP Config compares the record count with.
+var clientLimits = map[string]struct {
+	what  string
+	limit func(*client.ISPConfigClient) *client.FlexInt
+}{
+	"limit_web_domain": {
+		what:  "web domains",
+		limit: func(c *client.ISPConfigClient) *client.FlexInt { return c.LimitWeb },
+	},
+	"limit_mailbox": {
+		what:  "mailboxes",
+		limit: func(c *client.ISPConfigClient) *client.FlexInt { return c.LimitMailbox },
+	},
+	"limit_database": {
+		what:  "databases",
+		limit: func(c *client.ISPConfigClient) *client.FlexInt { return c.LimitDatabase },
+	},
+}
+
+// checkClientLimit fails the plan of a new resource when its client has
+// already used up the given limit, instead of letting ISP Config reject the
+// add call mid-apply. Other resources created in the same plan are not
+// counted. If the limits cannot be read (e.g. the remote user lacks the
+// client functions), only a warning is added.
+func checkClientLimit(ctx context.Context, c *client.Client, defaultClientID int, limit string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if c == nil || !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planClientID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("client_id"), &planClientID)...)
+	if resp.Diagnostics.HasError() || planClientID.IsUnknown() {
+		return
+	}
+	clientID := defaultClientID
+	if !planClientID.IsNull() {
+		clientID = int(planClientID.ValueInt64())
+	}
+	if clientID == 0 {
+		return
+	}
+
+	check := clientLimits[limit]
+	ispClient, err := c.GetClient(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not check client limits",
+			fmt.Sprintf("Could not read client ID %d to check %s: %s", clientID, limit, err.Error()),
+		)
+		return
+	}
+	max := check.limit(ispClient)
+	if max == nil || *max < 0 {
+		return
+	}
+
+	used, err := c.CountClientRecords(ctx, clientID, limit)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not check client limits",
+			fmt.Sprintf("Could not count the %s of client ID %d: %s", check.what, clientID, err.Error()),
+		)
+		return
+	}
+
+	if used >= int(*max) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Client Limit Reached",
+			fmt.Sprintf("Client ID %d already has %d of the %d %s allowed by %s, so ISP Config would reject a new one. "+
+				"Raise the client limit or remove unused %s.", clientID, used, int(*max), check.what, limit, check.what),
+		)
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *ISPConfigProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewWebHostingResource,
+		NewWebVhostSubdomainResource,
+		NewWebVhostAliasResource,
 		NewWebUserResource,
+		NewFTPUserResource,
+		NewWebDAVUserResource,
+		NewWebFolderResource,
+		NewWebFolderUserResource,
 		NewMySQLDatabaseResource,
 		NewMySQLDatabaseUserResource,
 		NewPgSQLDatabaseResource,
@@ -266,6 +739,9 @@ func (p *ISPConfigProvider) Resources(_ context.Context) []func() resource.Resou
 		NewEmailDomainResource,
 		NewEmailInboxResource,
 		NewCronTaskResource,
+		NewClientResource,
+		NewResellerResource,
+		NewServerIPResource,
 	}
 }
 
@@ -281,9 +757,25 @@ func (p *ISPConfigProvider) DataSources(_ context.Context) []func() datasource.D
 		NewWebDatabaseDataSource,
 		NewWebDatabaseUserDataSource,
 		NewClientDataSource,
+		NewClientTemplateDataSource,
+		NewResellerClientsDataSource,
+		NewServerDataSource,
+		NewServersDataSource,
+		NewServerIPDataSource,
+		NewServerIPsDataSource,
+		NewPHPVersionsDataSource,
 		NewEmailDomainDataSource,
 		NewEmailInboxDataSource,
 		NewCronTaskDataSource,
+		NewBackupsDataSource,
+		NewWebUsageDataSource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *ISPConfigProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewBackupRestoreAction,
 	}
 }
 
@@ -0,0 +1,777 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &clientResource{}
+	_ resource.ResourceWithConfigure      = &clientResource{}
+	_ resource.ResourceWithImportState    = &clientResource{}
+	_ resource.ResourceWithValidateConfig = &clientResource{}
+	_ resource.ResourceWithModifyPlan     = &clientResource{}
+)
+
+// NewClientResource is a helper function to simplify the provider implementation.
+func NewClientResource() resource.Resource {
+	return &clientResource{}
+}
+
+// clientResource is the resource implementation.
+type clientResource struct {
+	client     *client.Client
+	resellerID int
+}
+
+// clientResourceModel maps the resource schema data.
+type clientResourceModel struct {
+	clientBaseModel
+	ParentClientID types.Int64 `tfsdk:"parent_client_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// clientBaseModel maps the attributes shared by clients and resellers.
+type clientBaseModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	CompanyName        types.String `tfsdk:"company_name"`
+	ContactName        types.String `tfsdk:"contact_name"`
+	CustomerNo         types.String `tfsdk:"customer_no"`
+	VATNumber          types.String `tfsdk:"vat_number"`
+	Street             types.String `tfsdk:"street"`
+	Zip                types.String `tfsdk:"zip"`
+	City               types.String `tfsdk:"city"`
+	State              types.String `tfsdk:"state"`
+	Country            types.String `tfsdk:"country"`
+	Phone              types.String `tfsdk:"phone"`
+	Mobile             types.String `tfsdk:"mobile"`
+	Fax                types.String `tfsdk:"fax"`
+	Email              types.String `tfsdk:"email"`
+	Internet           types.String `tfsdk:"internet"`
+	Notes              types.String `tfsdk:"notes"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	PasswordVersion    types.Int64  `tfsdk:"password_version"`
+	Language           types.String `tfsdk:"language"`
+	UserTheme          types.String `tfsdk:"usertheme"`
+	Locked             types.Bool   `tfsdk:"locked"`
+	Canceled           types.Bool   `tfsdk:"canceled"`
+	DefaultWebserver   types.Int64  `tfsdk:"default_webserver"`
+	DefaultMailserver  types.Int64  `tfsdk:"default_mailserver"`
+	DefaultDBserver    types.Int64  `tfsdk:"default_dbserver"`
+	TemplateMaster     types.Int64  `tfsdk:"template_master"`
+	TemplateAdditional types.Set    `tfsdk:"template_additional"`
+	LimitWeb           types.Int64  `tfsdk:"limit_web"`
+	LimitWebQuota      types.Int64  `tfsdk:"limit_web_quota"`
+	LimitTrafficQuota  types.Int64  `tfsdk:"limit_traffic_quota"`
+	LimitWebSubdomain  types.Int64  `tfsdk:"limit_web_subdomain"`
+	LimitWebAlias      types.Int64  `tfsdk:"limit_web_alias"`
+	LimitFTPUser       types.Int64  `tfsdk:"limit_ftp_user"`
+	LimitShellUser     types.Int64  `tfsdk:"limit_shell_user"`
+	LimitWebdavUser    types.Int64  `tfsdk:"limit_webdav_user"`
+	LimitDatabase      types.Int64  `tfsdk:"limit_database"`
+	LimitDatabaseQuota types.Int64  `tfsdk:"limit_database_quota"`
+	LimitCron          types.Int64  `tfsdk:"limit_cron"`
+	LimitMailDomain    types.Int64  `tfsdk:"limit_mail_domain"`
+	LimitMailbox       types.Int64  `tfsdk:"limit_mailbox"`
+	LimitMailQuota     types.Int64  `tfsdk:"limit_mail_quota"`
+}
+
+// Metadata returns the resource type name.
+func (r *clientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client"
+}
+
+// clientLimitAttributes lists the limit attributes of the client resource.
+var clientLimitAttributes = []string{
+	"limit_web", "limit_web_quota", "limit_traffic_quota", "limit_web_subdomain", "limit_web_alias",
+	"limit_ftp_user", "limit_shell_user", "limit_webdav_user", "limit_database", "limit_database_quota",
+	"limit_cron", "limit_mail_domain", "limit_mailbox", "limit_mail_quota",
+}
+
+// clientLimitAttribute returns the schema of a client limit. ISPConfig uses
+// -1 for unlimited and 0 for no access.
+func clientLimitAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description + " (-1 for unlimited, 0 for none). Defaults to the ISP Config default or the master template.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// clientBaseAttributes returns the schema attributes shared by clients and
+// resellers.
+func clientBaseAttributes() map[string]schema.Attribute {
+	optionalAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+		}
+	}
+	defaultServer := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"company_name": optionalAttribute("The company name."),
+		"contact_name": schema.StringAttribute{
+			Description: "The contact name.",
+			Required:    true,
+		},
+		"customer_no": schema.StringAttribute{
+			Description: "The customer number. Generated by ISP Config when not set and a customer number template is configured.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"vat_number": optionalAttribute("The VAT ID."),
+		"street":     optionalAttribute("The street."),
+		"zip":        optionalAttribute("The ZIP code."),
+		"city":       optionalAttribute("The city."),
+		"state":      optionalAttribute("The state."),
+		"country": schema.StringAttribute{
+			Description: "The two-letter country code (e.g. 'DE').",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"phone":  optionalAttribute("The telephone number."),
+		"mobile": optionalAttribute("The mobile number."),
+		"fax":    optionalAttribute("The fax number."),
+		"email": schema.StringAttribute{
+			Description: "The email address.",
+			Required:    true,
+		},
+		"internet": optionalAttribute("The website URL."),
+		"notes":    optionalAttribute("Internal notes."),
+		"username": schema.StringAttribute{
+			Description: "The panel login name.",
+			Required:    true,
+		},
+		"password": schema.StringAttribute{
+			Description: "The panel login password. This value is write-only and never stored in the Terraform state " +
+				"(requires Terraform 1.11 or later). Change password_version to apply a new password.",
+			Required:  true,
+			Sensitive: true,
+			WriteOnly: true,
+		},
+		"password_version": schema.Int64Attribute{
+			Description: "An arbitrary version number for the password. Changing it sends the current password to ISP Config.",
+			Optional:    true,
+		},
+		"language": schema.StringAttribute{
+			Description: "The panel language (e.g. 'en', 'de').",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("en"),
+		},
+		"usertheme": schema.StringAttribute{
+			Description: "The panel theme.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("default"),
+		},
+		"locked": schema.BoolAttribute{
+			Description: "Lock the client: disables its websites, mailboxes and other services.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"canceled": schema.BoolAttribute{
+			Description: "Cancel the client: disables its panel login.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"default_webserver":  defaultServer("The default web server of the client."),
+		"default_mailserver": defaultServer("The default mail server of the client."),
+		"default_dbserver":   defaultServer("The default database server of the client."),
+		"template_master": schema.Int64Attribute{
+			Description: "The ID of the master client template (hosting package) whose limits apply to the client. 0 (default) for none; " +
+				"limits must then be set on the client itself.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(0),
+		},
+		"template_additional": schema.SetAttribute{
+			Description: "The IDs of additional client templates (add-ons) assigned to the client.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+		},
+		"limit_web":            clientLimitAttribute("Maximum number of web domains"),
+		"limit_web_quota":      clientLimitAttribute("Total web disk quota in MB"),
+		"limit_traffic_quota":  clientLimitAttribute("Total monthly web traffic quota in MB"),
+		"limit_web_subdomain":  clientLimitAttribute("Maximum number of subdomains"),
+		"limit_web_alias":      clientLimitAttribute("Maximum number of alias domains"),
+		"limit_ftp_user":       clientLimitAttribute("Maximum number of FTP users"),
+		"limit_shell_user":     clientLimitAttribute("Maximum number of shell users"),
+		"limit_webdav_user":    clientLimitAttribute("Maximum number of WebDAV users"),
+		"limit_database":       clientLimitAttribute("Maximum number of databases"),
+		"limit_database_quota": clientLimitAttribute("Total database quota in MB"),
+		"limit_cron":           clientLimitAttribute("Maximum number of cron jobs"),
+		"limit_mail_domain":    clientLimitAttribute("Maximum number of mail domains"),
+		"limit_mailbox":        clientLimitAttribute("Maximum number of mailboxes"),
+		"limit_mail_quota":     clientLimitAttribute("Total mailbox quota in MB"),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *clientResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := clientBaseAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Description: "The ID of the client. Use it as client_id of the client's resources.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["parent_client_id"] = schema.Int64Attribute{
+		Description: "The ID of the reseller the client belongs to. Defaults to the provider reseller_id, or 0 for a client of the admin.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an ISP Config client (customer) and its panel login.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clientResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.resellerID = providerData.ResellerID
+}
+
+// apiClient builds the API record from the plan. The password is write-only
+// and has to be set from the configuration.
+func (m clientBaseModel) apiClient() *client.ISPConfigClient {
+	ispClient := &client.ISPConfigClient{
+		CompanyName:        m.CompanyName.ValueString(),
+		ContactName:        m.ContactName.ValueString(),
+		VATNumber:          m.VATNumber.ValueString(),
+		Street:             m.Street.ValueString(),
+		Zip:                m.Zip.ValueString(),
+		City:               m.City.ValueString(),
+		State:              m.State.ValueString(),
+		Phone:              m.Phone.ValueString(),
+		Mobile:             m.Mobile.ValueString(),
+		Fax:                m.Fax.ValueString(),
+		Email:              m.Email.ValueString(),
+		Internet:           m.Internet.ValueString(),
+		Notes:              m.Notes.ValueString(),
+		Username:           m.Username.ValueString(),
+		Language:           m.Language.ValueString(),
+		UseTheme:           m.UserTheme.ValueString(),
+		Locked:             boolToYN(m.Locked.ValueBool()),
+		Canceled:           boolToYN(m.Canceled.ValueBool()),
+		TemplateMaster:     optionalFlexInt(m.TemplateMaster),
+		LimitWeb:           optionalFlexInt(m.LimitWeb),
+		LimitWebQuota:      optionalFlexInt(m.LimitWebQuota),
+		LimitTrafficQuota:  optionalFlexInt(m.LimitTrafficQuota),
+		LimitWebSubdomain:  optionalFlexInt(m.LimitWebSubdomain),
+		LimitWebAlias:      optionalFlexInt(m.LimitWebAlias),
+		LimitFTPUser:       optionalFlexInt(m.LimitFTPUser),
+		LimitShellUser:     optionalFlexInt(m.LimitShellUser),
+		LimitWebdavUser:    optionalFlexInt(m.LimitWebdavUser),
+		LimitDatabase:      optionalFlexInt(m.LimitDatabase),
+		LimitDatabaseQuota: optionalFlexInt(m.LimitDatabaseQuota),
+		LimitCron:          optionalFlexInt(m.LimitCron),
+		LimitMailDomain:    optionalFlexInt(m.LimitMailDomain),
+		LimitMailbox:       optionalFlexInt(m.LimitMailbox),
+		LimitMailQuota:     optionalFlexInt(m.LimitMailQuota),
+	}
+	if !m.CustomerNo.IsNull() && !m.CustomerNo.IsUnknown() {
+		ispClient.CustomerNo = m.CustomerNo.ValueString()
+	}
+	if !m.Country.IsNull() && !m.Country.IsUnknown() {
+		ispClient.Country = m.Country.ValueString()
+	}
+	if !m.DefaultWebserver.IsNull() && !m.DefaultWebserver.IsUnknown() {
+		ispClient.DefaultWebserver = client.FlexInt(m.DefaultWebserver.ValueInt64())
+	}
+	if !m.DefaultMailserver.IsNull() && !m.DefaultMailserver.IsUnknown() {
+		ispClient.DefaultMailserver = client.FlexInt(m.DefaultMailserver.ValueInt64())
+	}
+	if !m.DefaultDBserver.IsNull() && !m.DefaultDBserver.IsUnknown() {
+		ispClient.DefaultDBserver = client.FlexInt(m.DefaultDBserver.ValueInt64())
+	}
+	return ispClient
+}
+
+// optionalFlexInt converts an Optional+Computed attribute for the API; null
+// and unknown values are omitted.
+func optionalFlexInt(v types.Int64) *client.FlexInt {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := client.FlexInt(v.ValueInt64())
+	return &i
+}
+
+// flexIntValue converts an optional API value.
+func flexIntValue(v *client.FlexInt) types.Int64 {
+	if v == nil {
+		return types.Int64Value(0)
+	}
+	return types.Int64Value(int64(*v))
+}
+
+// setAPIClient copies the API record into the model.
+func (m *clientResourceModel) setAPIClient(ispClient *client.ISPConfigClient) {
+	m.clientBaseModel.setAPIClient(ispClient)
+	m.ParentClientID = types.Int64Value(int64(ispClient.ParentClientID))
+}
+
+// setAPIClient copies the API record into the model. The password is left
+// untouched: the API only returns its hash.
+func (m *clientBaseModel) setAPIClient(ispClient *client.ISPConfigClient) {
+	m.CompanyName = optionalString(m.CompanyName, ispClient.CompanyName)
+	m.ContactName = types.StringValue(ispClient.ContactName)
+	m.CustomerNo = types.StringValue(ispClient.CustomerNo)
+	m.VATNumber = optionalString(m.VATNumber, ispClient.VATNumber)
+	m.Street = optionalString(m.Street, ispClient.Street)
+	m.Zip = optionalString(m.Zip, ispClient.Zip)
+	m.City = optionalString(m.City, ispClient.City)
+	m.State = optionalString(m.State, ispClient.State)
+	m.Country = types.StringValue(ispClient.Country)
+	m.Phone = optionalString(m.Phone, ispClient.Phone)
+	m.Mobile = optionalString(m.Mobile, ispClient.Mobile)
+	m.Fax = optionalString(m.Fax, ispClient.Fax)
+	m.Email = types.StringValue(ispClient.Email)
+	m.Internet = optionalString(m.Internet, ispClient.Internet)
+	m.Notes = optionalString(m.Notes, ispClient.Notes)
+	m.Username = types.StringValue(ispClient.Username)
+	if ispClient.Language != "" {
+		m.Language = types.StringValue(ispClient.Language)
+	}
+	if ispClient.UseTheme != "" {
+		m.UserTheme = types.StringValue(ispClient.UseTheme)
+	}
+	m.Locked = types.BoolValue(ynToBool(ispClient.Locked))
+	m.Canceled = types.BoolValue(ynToBool(ispClient.Canceled))
+	m.DefaultWebserver = types.Int64Value(int64(ispClient.DefaultWebserver))
+	m.DefaultMailserver = types.Int64Value(int64(ispClient.DefaultMailserver))
+	m.DefaultDBserver = types.Int64Value(int64(ispClient.DefaultDBserver))
+	m.TemplateMaster = flexIntValue(ispClient.TemplateMaster)
+	m.LimitWeb = flexIntValue(ispClient.LimitWeb)
+	m.LimitWebQuota = flexIntValue(ispClient.LimitWebQuota)
+	m.LimitTrafficQuota = flexIntValue(ispClient.LimitTrafficQuota)
+	m.LimitWebSubdomain = flexIntValue(ispClient.LimitWebSubdomain)
+	m.LimitWebAlias = flexIntValue(ispClient.LimitWebAlias)
+	m.LimitFTPUser = flexIntValue(ispClient.LimitFTPUser)
+	m.LimitShellUser = flexIntValue(ispClient.LimitShellUser)
+	m.LimitWebdavUser = flexIntValue(ispClient.LimitWebdavUser)
+	m.LimitDatabase = flexIntValue(ispClient.LimitDatabase)
+	m.LimitDatabaseQuota = flexIntValue(ispClient.LimitDatabaseQuota)
+	m.LimitCron = flexIntValue(ispClient.LimitCron)
+	m.LimitMailDomain = flexIntValue(ispClient.LimitMailDomain)
+	m.LimitMailbox = flexIntValue(ispClient.LimitMailbox)
+	m.LimitMailQuota = flexIntValue(ispClient.LimitMailQuota)
+}
+
+// ValidateConfig rejects limits next to a master template, whose limits
+// ISP Config applies instead.
+func (r *clientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateClientLimits(ctx, req, resp)
+}
+
+// validateClientLimits rejects limits next to a master template.
+func validateClientLimits(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var templateMaster types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("template_master"), &templateMaster)...)
+	if resp.Diagnostics.HasError() || templateMaster.IsNull() || templateMaster.IsUnknown() || templateMaster.ValueInt64() == 0 {
+		return
+	}
+
+	for _, name := range clientLimitAttributes {
+		var limit types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &limit)...)
+		if !limit.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting Client Limit",
+				fmt.Sprintf("%s cannot be set together with template_master: the limits of the master template apply.", name),
+			)
+		}
+	}
+}
+
+// ModifyPlan defaults parent_client_id to the provider reseller and marks
+// the limits that are not configured as unknown when the templates change.
+func (r *clientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var parentClientID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parent_client_id"), &parentClientID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if parentClientID.IsUnknown() && req.State.Raw.IsNull() {
+		parentClientID = types.Int64Value(int64(r.resellerID))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parent_client_id"), parentClientID)...)
+	}
+	if r.resellerID != 0 && !parentClientID.IsUnknown() && parentClientID.ValueInt64() != int64(r.resellerID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent_client_id"),
+			"Client Not Owned by Reseller",
+			fmt.Sprintf("parent_client_id must be the reseller ID %d configured in the provider.", r.resellerID),
+		)
+		return
+	}
+
+	planClientLimits(ctx, req, resp)
+}
+
+// planClientLimits marks the limits that are not configured as unknown when
+// the templates change, since ISP Config recalculates them from the
+// templates.
+func planClientLimits(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, name := range []string{"template_master", "template_additional"} {
+		var plan, state attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &plan)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Equal(state) {
+			continue
+		}
+
+		for _, name := range clientLimitAttributes {
+			var limit types.Int64
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &limit)...)
+			if limit.IsNull() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.Int64Unknown())...)
+			}
+		}
+		return
+	}
+}
+
+// syncAdditionalTemplates assigns the additional templates in want to the
+// client and removes all other assignments.
+func syncAdditionalTemplates(ctx context.Context, c *client.Client, clientID int, want types.Set) error {
+	var templateIDs []int64
+	if !want.IsNull() && !want.IsUnknown() {
+		if diags := want.ElementsAs(ctx, &templateIDs, false); diags.HasError() {
+			return fmt.Errorf("could not read template_additional")
+		}
+	}
+	wanted := map[int]bool{}
+	for _, id := range templateIDs {
+		wanted[int(id)] = true
+	}
+
+	assignments, err := c.GetClientAdditionalTemplates(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	assigned := map[int]bool{}
+	for _, a := range assignments {
+		templateID := int(a.TemplateID)
+		if wanted[templateID] && !assigned[templateID] {
+			assigned[templateID] = true
+			continue
+		}
+		if err := c.DeleteClientAdditionalTemplate(ctx, clientID, int(a.ID)); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range templateIDs {
+		if assigned[int(id)] {
+			continue
+		}
+		if _, err := c.AddClientAdditionalTemplate(ctx, clientID, int(id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readAdditionalTemplates returns the IDs of the additional templates
+// assigned to the client. A null current value stays null when there are
+// none.
+func readAdditionalTemplates(ctx context.Context, c *client.Client, clientID int, current types.Set) (types.Set, error) {
+	assignments, err := c.GetClientAdditionalTemplates(ctx, clientID)
+	if err != nil {
+		return current, err
+	}
+	if len(assignments) == 0 && current.IsNull() {
+		return current, nil
+	}
+
+	templateIDs := make([]int64, 0, len(assignments))
+	seen := map[int64]bool{}
+	for _, a := range assignments {
+		if id := int64(a.TemplateID); !seen[id] {
+			seen[id] = true
+			templateIDs = append(templateIDs, id)
+		}
+	}
+	value, diags := types.SetValueFrom(ctx, types.Int64Type, templateIDs)
+	if diags.HasError() {
+		return current, fmt.Errorf("could not convert additional templates")
+	}
+	return value, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	password, err := writeOnlyString(ctx, req.Config, "password")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading password", err.Error())
+		return
+	}
+	ispClient := plan.apiClient()
+	ispClient.Password = password
+
+	// Create client
+	clientID, err := r.client.AddClient(ctx, ispClient, int(plan.ParentClientID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating client",
+			"Could not create client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created client", map[string]interface{}{"id": clientID, "username": plan.Username.ValueString()})
+
+	plan.ID = types.Int64Value(int64(clientID))
+
+	if err := syncAdditionalTemplates(ctx, r.client, clientID, plan.TemplateAdditional); err != nil {
+		resp.Diagnostics.AddError(
+			"Error assigning client templates",
+			fmt.Sprintf("Could not assign additional templates to client ID %d: %s", clientID, err.Error()),
+		)
+		// Save the client so it is tainted rather than orphaned
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	// Read back the values ISP Config fills in (customer number, default
+	// servers, template limits)
+	createdClient, err := r.client.GetClient(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created client",
+			fmt.Sprintf("Could not read client ID %d after creation: %s", clientID, err.Error()),
+		)
+		return
+	}
+	plan.setAPIClient(createdClient)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, types.Int64Null())...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := int(state.ID.ValueInt64())
+
+	ispClient, err := r.client.GetClient(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading client",
+			fmt.Sprintf("Could not read client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	state.setAPIClient(ispClient)
+	state.TemplateAdditional, err = readAdditionalTemplates(ctx, r.client, clientID, state.TemplateAdditional)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading client templates",
+			fmt.Sprintf("Could not read additional templates of client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan clientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	clientID := int(plan.ID.ValueInt64())
+
+	// The password is always sent: client_update merges the stored record
+	// into the params, and an omitted password would be replaced by its hash.
+	password, err := writeOnlyString(ctx, req.Config, "password")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading password", err.Error())
+		return
+	}
+	ispClient := plan.apiClient()
+	ispClient.Password = password
+
+	err = r.client.UpdateClient(ctx, clientID, int(plan.ParentClientID.ValueInt64()), ispClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating client",
+			fmt.Sprintf("Could not update client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	if err := syncAdditionalTemplates(ctx, r.client, clientID, plan.TemplateAdditional); err != nil {
+		resp.Diagnostics.AddError(
+			"Error assigning client templates",
+			fmt.Sprintf("Could not update additional templates of client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated client", map[string]interface{}{"id": clientID})
+
+	updatedClient, err := r.client.GetClient(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading updated client",
+			fmt.Sprintf("Could not read client ID %d after update: %s", clientID, err.Error()),
+		)
+		return
+	}
+	plan.setAPIClient(updatedClient)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, types.Int64Null())...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *clientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state clientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	clientID := int(state.ID.ValueInt64())
+
+	err := r.client.DeleteClient(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting client",
+			fmt.Sprintf("Could not delete client ID %d: %s", clientID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted client", map[string]interface{}{"id": clientID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, types.Int64Null())...)
+}
+
+// ImportState imports the resource state.
+func (r *clientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert the import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
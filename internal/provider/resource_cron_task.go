@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +23,7 @@ var (
 	_ resource.Resource                = &cronTaskResource{}
 	_ resource.ResourceWithConfigure   = &cronTaskResource{}
 	_ resource.ResourceWithImportState = &cronTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &cronTaskResource{}
 )
 
 func NewCronTaskResource() resource.Resource {
@@ -28,9 +31,7 @@ func NewCronTaskResource() resource.Resource {
 }
 
 type cronTaskResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 type cronTaskResourceModel struct {
@@ -42,13 +43,15 @@ type cronTaskResourceModel struct {
 	Type           types.String `tfsdk:"type"`
 	Active         types.Bool   `tfsdk:"active"`
 	ServerID       types.Int64  `tfsdk:"server_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *cronTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cron_task"
 }
 
-func (r *cronTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *cronTaskResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a cron task in ISP Config.",
 		Attributes: map[string]schema.Attribute{
@@ -93,6 +96,9 @@ func (r *cronTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -110,9 +116,7 @@ func (r *cronTaskResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
 }
 
 func (r *cronTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -122,15 +126,9 @@ func (r *cronTaskResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -180,6 +178,8 @@ func (r *cronTaskResource) Create(ctx context.Context, req resource.CreateReques
 	plan.ServerID = types.Int64Value(int64(serverID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *cronTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -221,20 +221,14 @@ func (r *cronTaskResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	cronJobID := int(plan.ID.ValueInt64())
-
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	cronJobID := int(plan.ID.ValueInt64())
+
 	runMin, runHour, runMday, runMonth, runWday, err := parseCronSchedule(plan.Schedule.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Schedule", err.Error())
@@ -280,6 +274,8 @@ func (r *cronTaskResource) Update(ctx context.Context, req resource.UpdateReques
 	plan.ServerID = types.Int64Value(int64(serverID))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *cronTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -289,6 +285,12 @@ func (r *cronTaskResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cronJobID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteCronJob(ctx, cronJobID)
@@ -301,6 +303,10 @@ func (r *cronTaskResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	tflog.Trace(ctx, "Deleted cron task", map[string]interface{}{"id": cronJobID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
 func (r *cronTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
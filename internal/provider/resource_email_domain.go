@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,6 +23,7 @@ var (
 	_ resource.ResourceWithConfigure      = &emailDomainResource{}
 	_ resource.ResourceWithImportState    = &emailDomainResource{}
 	_ resource.ResourceWithUpgradeState   = &emailDomainResource{}
+	_ resource.ResourceWithModifyPlan     = &emailDomainResource{}
 )
 
 func NewEmailDomainResource() resource.Resource {
@@ -29,9 +31,7 @@ func NewEmailDomainResource() resource.Resource {
 }
 
 type emailDomainResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 type emailDomainResourceModel struct {
@@ -41,13 +41,15 @@ type emailDomainResourceModel struct {
 	ServerID      types.Int64  `tfsdk:"server_id"`
 	Active        types.Bool   `tfsdk:"active"`
 	LocalDelivery types.Bool   `tfsdk:"local_delivery"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *emailDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_email_domain"
 }
 
-func (r *emailDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *emailDomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an email domain in ISP Config.",
 		Version:     1,
@@ -85,6 +87,9 @@ func (r *emailDomainResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Default:     booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -102,9 +107,7 @@ func (r *emailDomainResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
 }
 
 func (r *emailDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -114,24 +117,18 @@ func (r *emailDomainResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "email_domain Create: provider defaults", map[string]interface{}{
 		"r.serverID":           r.serverID,
 		"r.clientID":           r.clientID,
 		"plan.ServerID.IsNull": plan.ServerID.IsNull(),
 	})
 
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
-		return
-	}
-
 	mailDomain := &client.MailDomain{
 		Domain:        plan.Domain.ValueString(),
 		Active:        boolToYN(plan.Active.ValueBool()),
@@ -187,6 +184,8 @@ func (r *emailDomainResource) Create(ctx context.Context, req resource.CreateReq
 	plan.LocalDelivery = types.BoolValue(ynToBool(created.LocalDelivery))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *emailDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -226,20 +225,14 @@ func (r *emailDomainResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	mailDomainID := int(plan.ID.ValueInt64())
-
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	mailDomainID := int(plan.ID.ValueInt64())
+
 	mailDomain := &client.MailDomain{
 		Domain:        plan.Domain.ValueString(),
 		Active:        boolToYN(plan.Active.ValueBool()),
@@ -280,6 +273,8 @@ func (r *emailDomainResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.LocalDelivery = types.BoolValue(ynToBool(updated.LocalDelivery))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *emailDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -289,6 +284,12 @@ func (r *emailDomainResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mailDomainID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteMailDomain(ctx, mailDomainID)
@@ -301,6 +302,10 @@ func (r *emailDomainResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	tflog.Trace(ctx, "Deleted email domain", map[string]interface{}{"id": mailDomainID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
 func (r *emailDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -353,6 +358,7 @@ func (r *emailDomainResource) UpgradeState(_ context.Context) map[int64]resource
 				newState.Domain = oldState.Domain
 				newState.ServerID = oldState.ServerID
 				newState.LocalDelivery = oldState.LocalDelivery
+				newState.Timeouts = nullTimeouts()
 
 				if oldState.Active.IsNull() || oldState.Active.IsUnknown() {
 					newState.Active = types.BoolValue(true)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +22,7 @@ var (
 	_ resource.Resource                = &emailInboxResource{}
 	_ resource.ResourceWithConfigure   = &emailInboxResource{}
 	_ resource.ResourceWithImportState = &emailInboxResource{}
+	_ resource.ResourceWithModifyPlan  = &emailInboxResource{}
 )
 
 func NewEmailInboxResource() resource.Resource {
@@ -28,9 +30,7 @@ func NewEmailInboxResource() resource.Resource {
 }
 
 type emailInboxResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 type emailInboxResourceModel struct {
@@ -44,15 +44,18 @@ type emailInboxResourceModel struct {
 	ForwardIncomingTo types.String `tfsdk:"forward_incoming_to"`
 	ForwardOutgoingTo types.String `tfsdk:"forward_outgoing_to"`
 	ReceiveMessages   types.Bool   `tfsdk:"receive_messages"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *emailInboxResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_email_inbox"
 }
 
-func (r *emailInboxResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *emailInboxResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages an email inbox (mailbox) in ISP Config. Inboxes must be assigned to an email domain.",
+		Description: "Manages an email inbox (mailbox) in ISP Config. Inboxes must be assigned to an email domain. " +
+			"Planning a new inbox fails when the client has used up limit_mailbox; other inboxes in the same plan are not counted.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "The ID of the email inbox.",
@@ -105,6 +108,9 @@ func (r *emailInboxResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Default:     booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -122,9 +128,12 @@ func (r *emailInboxResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
+}
+
+func (r *emailInboxResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.clientScope.ModifyPlan(ctx, req, resp)
+	checkClientLimit(ctx, r.client, r.clientID, "limit_mailbox", req, resp)
 }
 
 func (r *emailInboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -134,15 +143,9 @@ func (r *emailInboxResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -212,6 +215,8 @@ func (r *emailInboxResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *emailInboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -259,20 +264,14 @@ func (r *emailInboxResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	mailUserID := int(plan.ID.ValueInt64())
-
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	mailUserID := int(plan.ID.ValueInt64())
+
 	emailAddr := plan.Email.ValueString()
 	mailUser := &client.MailUser{
 		MailDomainID:   client.FlexInt(plan.MailDomainID.ValueInt64()),
@@ -338,6 +337,8 @@ func (r *emailInboxResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *emailInboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -347,6 +348,12 @@ func (r *emailInboxResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mailUserID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteMailUser(ctx, mailUserID)
@@ -359,6 +366,10 @@ func (r *emailInboxResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	tflog.Trace(ctx, "Deleted email inbox", map[string]interface{}{"id": mailUserID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
 func (r *emailInboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,439 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ftpUserResource{}
+	_ resource.ResourceWithConfigure   = &ftpUserResource{}
+	_ resource.ResourceWithImportState = &ftpUserResource{}
+	_ resource.ResourceWithModifyPlan  = &ftpUserResource{}
+)
+
+// NewFTPUserResource is a helper function to simplify the provider implementation.
+func NewFTPUserResource() resource.Resource {
+	return &ftpUserResource{}
+}
+
+// ftpUserResource is the resource implementation.
+type ftpUserResource struct {
+	clientScope
+}
+
+// ftpUserResourceModel maps the resource schema data.
+type ftpUserResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	ClientID       types.Int64  `tfsdk:"client_id"`
+	ParentDomainID types.Int64  `tfsdk:"parent_domain_id"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	QuotaSize      types.Int64  `tfsdk:"quota_size"`
+	Dir            types.String `tfsdk:"dir"`
+	Expires        types.String `tfsdk:"expires"`
+	Active         types.Bool   `tfsdk:"active"`
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	UID            types.String `tfsdk:"uid"`
+	GID            types.String `tfsdk:"gid"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *ftpUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ftp_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *ftpUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an FTP user in ISP Config.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the FTP user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.Int64Attribute{
+				Description: "The ISP Config client ID.",
+				Optional:    true,
+			},
+			"parent_domain_id": schema.Int64Attribute{
+				Description: "The ID of the web hosting domain the FTP user belongs to.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The FTP username.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The FTP user password.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"quota_size": schema.Int64Attribute{
+				Description: "Harddisk quota in MB. Use -1 for unlimited. Defaults to -1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(-1),
+			},
+			"dir": schema.StringAttribute{
+				Description: "The FTP user directory. Defaults to the document root of the parent domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"expires": schema.StringAttribute{
+				Description: "Expiry date of the FTP user as an RFC 3339 timestamp (e.g. '2026-12-31T23:59:59Z'). " +
+					"ISPConfig compares it with the server's local time, so the wall-clock time is sent unchanged and the offset is ignored. " +
+					"Leave unset for no expiry.",
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the FTP user is active.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "The server ID. Defaults to the server of the parent domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"uid": schema.StringAttribute{
+				Description: "The system user of the parent domain the FTP user acts as.",
+				Computed:    true,
+			},
+			"gid": schema.StringAttribute{
+				Description: "The system group of the parent domain the FTP user acts as.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ftpUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.configure(providerData)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ftpUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ftpUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch parent domain to get system user/group and document root
+	parentDomain, err := r.client.GetWebDomain(ctx, int(plan.ParentDomainID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching parent domain",
+			"Could not fetch parent domain to get system user/group: "+err.Error(),
+		)
+		return
+	}
+
+	ftpUser := &client.FTPUser{
+		Username:       plan.Username.ValueString(),
+		Password:       plan.Password.ValueString(),
+		ParentDomainID: client.FlexInt(plan.ParentDomainID.ValueInt64()),
+		QuotaSize:      client.FlexInt(plan.QuotaSize.ValueInt64()),
+		Active:         boolToYN(plan.Active.ValueBool()),
+		UID:            parentDomain.System,      // system_user from parent
+		GID:            parentDomain.SystemGroup, // system_group from parent
+		Dir:            parentDomain.DocumentRoot,
+	}
+
+	if !plan.Dir.IsNull() && !plan.Dir.IsUnknown() {
+		ftpUser.Dir = plan.Dir.ValueString()
+	}
+	if !plan.Expires.IsNull() {
+		expires, err := rfc3339ToAPIDateTime(plan.Expires.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires"), "Invalid Expiry Date", err.Error())
+			return
+		}
+		ftpUser.Expires = expires
+	}
+	if !plan.ServerID.IsNull() && !plan.ServerID.IsUnknown() {
+		ftpUser.ServerID = client.FlexInt(plan.ServerID.ValueInt64())
+	} else if parentDomain.ServerID != 0 {
+		ftpUser.ServerID = parentDomain.ServerID
+		plan.ServerID = types.Int64Value(int64(parentDomain.ServerID))
+	} else if r.serverID != 0 {
+		ftpUser.ServerID = client.FlexInt(r.serverID)
+		plan.ServerID = types.Int64Value(int64(r.serverID))
+	}
+
+	// Create FTP user
+	ftpUserID, err := r.client.AddFTPUser(ctx, ftpUser, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating FTP user",
+			"Could not create FTP user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created FTP user", map[string]interface{}{"id": ftpUserID})
+
+	plan.ID = types.Int64Value(int64(ftpUserID))
+
+	// Read back the created resource to get computed values
+	createdUser, err := r.client.GetFTPUser(ctx, ftpUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created FTP user",
+			"Could not read created FTP user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if plan.Dir.IsNull() || plan.Dir.IsUnknown() {
+		plan.Dir = types.StringValue(createdUser.Dir)
+	}
+	if plan.ServerID.IsNull() || plan.ServerID.IsUnknown() {
+		plan.ServerID = types.Int64Value(int64(createdUser.ServerID))
+	}
+	plan.UID = types.StringValue(createdUser.UID)
+	plan.GID = types.StringValue(createdUser.GID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ftpUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ftpUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ftpUserID := int(state.ID.ValueInt64())
+
+	ftpUser, err := r.client.GetFTPUser(ctx, ftpUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading FTP user",
+			fmt.Sprintf("Could not read FTP user ID %d: %s", ftpUserID, err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	state.Username = types.StringValue(ftpUser.Username)
+	// Note: Password is not returned by the API, so we keep the existing value
+	state.ParentDomainID = types.Int64Value(int64(ftpUser.ParentDomainID))
+	state.QuotaSize = types.Int64Value(int64(ftpUser.QuotaSize))
+	state.Dir = types.StringValue(ftpUser.Dir)
+	// Keep the configured timestamp if it has the same wall-clock time (e.g.
+	// with a non-UTC offset), otherwise take the value from the API.
+	expires := apiDateTimeToRFC3339(ftpUser.Expires)
+	if expires == "" {
+		state.Expires = types.StringNull()
+	} else if current, err := rfc3339ToAPIDateTime(state.Expires.ValueString()); err != nil || current != ftpUser.Expires {
+		state.Expires = types.StringValue(expires)
+	}
+	state.Active = types.BoolValue(ynToBool(ftpUser.Active))
+	if ftpUser.ServerID != 0 {
+		state.ServerID = types.Int64Value(int64(ftpUser.ServerID))
+	} else if r.serverID != 0 {
+		state.ServerID = types.Int64Value(int64(r.serverID))
+	}
+	state.UID = types.StringValue(ftpUser.UID)
+	state.GID = types.StringValue(ftpUser.GID)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ftpUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ftpUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ftpUserID := int(plan.ID.ValueInt64())
+
+	// Fetch parent domain to get system user/group and document root
+	parentDomain, err := r.client.GetWebDomain(ctx, int(plan.ParentDomainID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching parent domain",
+			"Could not fetch parent domain to get system user/group: "+err.Error(),
+		)
+		return
+	}
+
+	ftpUser := &client.FTPUser{
+		Username:       plan.Username.ValueString(),
+		Password:       plan.Password.ValueString(),
+		ParentDomainID: client.FlexInt(plan.ParentDomainID.ValueInt64()),
+		QuotaSize:      client.FlexInt(plan.QuotaSize.ValueInt64()),
+		Active:         boolToYN(plan.Active.ValueBool()),
+		UID:            parentDomain.System,      // system_user from parent
+		GID:            parentDomain.SystemGroup, // system_group from parent
+		Dir:            parentDomain.DocumentRoot,
+	}
+
+	if !plan.Dir.IsNull() && !plan.Dir.IsUnknown() {
+		ftpUser.Dir = plan.Dir.ValueString()
+	}
+	// Without expires an empty value is sent, which clears a previous date.
+	if !plan.Expires.IsNull() {
+		expires, err := rfc3339ToAPIDateTime(plan.Expires.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires"), "Invalid Expiry Date", err.Error())
+			return
+		}
+		ftpUser.Expires = expires
+	}
+	if !plan.ServerID.IsNull() && !plan.ServerID.IsUnknown() {
+		ftpUser.ServerID = client.FlexInt(plan.ServerID.ValueInt64())
+	} else if parentDomain.ServerID != 0 {
+		ftpUser.ServerID = parentDomain.ServerID
+		plan.ServerID = types.Int64Value(int64(parentDomain.ServerID))
+	} else if r.serverID != 0 {
+		ftpUser.ServerID = client.FlexInt(r.serverID)
+		plan.ServerID = types.Int64Value(int64(r.serverID))
+	}
+
+	// Update FTP user
+	err = r.client.UpdateFTPUser(ctx, ftpUserID, clientID, ftpUser)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating FTP user",
+			fmt.Sprintf("Could not update FTP user ID %d: %s", ftpUserID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated FTP user", map[string]interface{}{"id": ftpUserID})
+
+	// Read back the updated resource
+	updatedUser, err := r.client.GetFTPUser(ctx, ftpUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading updated FTP user",
+			"Could not read updated FTP user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if plan.Dir.IsNull() || plan.Dir.IsUnknown() {
+		plan.Dir = types.StringValue(updatedUser.Dir)
+	}
+	if plan.ServerID.IsNull() || plan.ServerID.IsUnknown() {
+		plan.ServerID = types.Int64Value(int64(updatedUser.ServerID))
+	}
+	plan.UID = types.StringValue(updatedUser.UID)
+	plan.GID = types.StringValue(updatedUser.GID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ftpUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ftpUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ftpUserID := int(state.ID.ValueInt64())
+
+	err := r.client.DeleteFTPUser(ctx, ftpUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting FTP user",
+			fmt.Sprintf("Could not delete FTP user ID %d: %s", ftpUserID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted FTP user", map[string]interface{}{"id": ftpUserID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
+}
+
+// ImportState imports the resource state.
+func (r *ftpUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert the import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
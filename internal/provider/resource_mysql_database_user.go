@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +22,7 @@ var (
 	_ resource.ResourceWithConfigure   = &mysqlDatabaseUserResource{}
 	_ resource.ResourceWithImportState = &mysqlDatabaseUserResource{}
 	_ resource.ResourceWithMoveState   = &mysqlDatabaseUserResource{}
+	_ resource.ResourceWithModifyPlan  = &mysqlDatabaseUserResource{}
 )
 
 func NewMySQLDatabaseUserResource() resource.Resource {
@@ -28,9 +30,7 @@ func NewMySQLDatabaseUserResource() resource.Resource {
 }
 
 type mysqlDatabaseUserResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 type mysqlDatabaseUserResourceModel struct {
@@ -39,13 +39,15 @@ type mysqlDatabaseUserResourceModel struct {
 	DatabaseUser     types.String `tfsdk:"database_user"`
 	DatabasePassword types.String `tfsdk:"database_password"`
 	ServerID         types.Int64  `tfsdk:"server_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *mysqlDatabaseUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mysql_database_user"
 }
 
-func (r *mysqlDatabaseUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *mysqlDatabaseUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a MySQL database user in ISP Config.",
 		Attributes: map[string]schema.Attribute{
@@ -75,6 +77,9 @@ func (r *mysqlDatabaseUserResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -92,9 +97,7 @@ func (r *mysqlDatabaseUserResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
 }
 
 func (r *mysqlDatabaseUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -104,15 +107,9 @@ func (r *mysqlDatabaseUserResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -154,6 +151,8 @@ func (r *mysqlDatabaseUserResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *mysqlDatabaseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -192,20 +191,14 @@ func (r *mysqlDatabaseUserResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	dbUserID := int(plan.ID.ValueInt64())
-
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	dbUserID := int(plan.ID.ValueInt64())
+
 	dbUser := &client.DatabaseUser{
 		DatabaseUser:     plan.DatabaseUser.ValueString(),
 		DatabasePassword: plan.DatabasePassword.ValueString(),
@@ -243,6 +236,8 @@ func (r *mysqlDatabaseUserResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *mysqlDatabaseUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -252,6 +247,12 @@ func (r *mysqlDatabaseUserResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dbUserID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteDatabaseUser(ctx, dbUserID)
@@ -264,12 +265,16 @@ func (r *mysqlDatabaseUserResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	tflog.Trace(ctx, "Deleted MySQL database user", map[string]interface{}{"id": dbUserID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
-func (r *mysqlDatabaseUserResource) MoveState(_ context.Context) []resource.StateMover {
+func (r *mysqlDatabaseUserResource) MoveState(ctx context.Context) []resource.StateMover {
 	return []resource.StateMover{
 		{
-			SourceSchema: webDatabaseUserSourceSchema(),
+			SourceSchema: webDatabaseUserSourceSchema(ctx),
 			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
 				if req.SourceTypeName != "ispconfig_web_database_user" {
 					return
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -18,10 +20,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &pgsqlDatabaseResource{}
-	_ resource.ResourceWithConfigure   = &pgsqlDatabaseResource{}
-	_ resource.ResourceWithImportState = &pgsqlDatabaseResource{}
-	_ resource.ResourceWithMoveState   = &pgsqlDatabaseResource{}
+	_ resource.Resource                   = &pgsqlDatabaseResource{}
+	_ resource.ResourceWithConfigure      = &pgsqlDatabaseResource{}
+	_ resource.ResourceWithImportState    = &pgsqlDatabaseResource{}
+	_ resource.ResourceWithMoveState      = &pgsqlDatabaseResource{}
+	_ resource.ResourceWithValidateConfig = &pgsqlDatabaseResource{}
+	_ resource.ResourceWithModifyPlan     = &pgsqlDatabaseResource{}
 )
 
 func NewPgSQLDatabaseResource() resource.Resource {
@@ -29,9 +33,7 @@ func NewPgSQLDatabaseResource() resource.Resource {
 }
 
 type pgsqlDatabaseResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 type pgsqlDatabaseResourceModel struct {
@@ -45,15 +47,20 @@ type pgsqlDatabaseResourceModel struct {
 	ServerID       types.Int64  `tfsdk:"server_id"`
 	RemoteAccess   types.Bool   `tfsdk:"remote_access"`
 	RemoteIPs      types.String `tfsdk:"remote_ips"`
+	BackupInterval types.String `tfsdk:"backup_interval"`
+	BackupCopies   types.Int64  `tfsdk:"backup_copies"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *pgsqlDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_pgsql_database"
 }
 
-func (r *pgsqlDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *pgsqlDatabaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a PostgreSQL database in ISP Config.",
+		Description: "Manages a PostgreSQL database in ISP Config. Planning a new database fails when the client has used up " +
+			"limit_database; databases created in the same plan are not counted.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "The ID of the database.",
@@ -106,10 +113,39 @@ func (r *pgsqlDatabaseResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:    true,
 				Computed:    true,
 			},
+			"backup_interval": schema.StringAttribute{
+				Description: "Database backup schedule: 'none', 'daily', 'weekly' or 'monthly'. Only sent when set, so a schedule configured in the panel is kept otherwise.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_copies": schema.Int64Attribute{
+				Description: "Number of database backups to keep (1-30). Only sent when set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
 
+func (r *pgsqlDatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config pgsqlDatabaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateBackupConfig(config.BackupInterval, config.BackupCopies)...)
+}
+
 func (r *pgsqlDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -124,9 +160,12 @@ func (r *pgsqlDatabaseResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
+}
+
+func (r *pgsqlDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.clientScope.ModifyPlan(ctx, req, resp)
+	checkClientLimit(ctx, r.client, r.clientID, "limit_database", req, resp)
 }
 
 func (r *pgsqlDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -136,15 +175,9 @@ func (r *pgsqlDatabaseResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -181,6 +214,7 @@ func (r *pgsqlDatabaseResource) Create(ctx context.Context, req resource.CreateR
 	if !plan.RemoteIPs.IsNull() {
 		database.RemoteIPs = plan.RemoteIPs.ValueString()
 	}
+	database.BackupInterval, database.BackupCopies = backupSettings(plan.BackupInterval, plan.BackupCopies)
 
 	databaseID, err := r.client.AddDatabase(ctx, database, clientID)
 	if err != nil {
@@ -221,8 +255,16 @@ func (r *pgsqlDatabaseResource) Create(ctx context.Context, req resource.CreateR
 	if plan.RemoteIPs.IsNull() || plan.RemoteIPs.IsUnknown() {
 		plan.RemoteIPs = types.StringValue(createdDB.RemoteIPs)
 	}
+	if plan.BackupInterval.IsNull() || plan.BackupInterval.IsUnknown() {
+		plan.BackupInterval = types.StringValue(createdDB.BackupInterval)
+	}
+	if plan.BackupCopies.IsNull() || plan.BackupCopies.IsUnknown() {
+		plan.BackupCopies = types.Int64Value(int64(createdDB.BackupCopies))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *pgsqlDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -259,6 +301,12 @@ func (r *pgsqlDatabaseResource) Read(ctx context.Context, req resource.ReadReque
 	}
 	state.RemoteAccess = types.BoolValue(ynToBool(database.RemoteAccess))
 	state.RemoteIPs = types.StringValue(database.RemoteIPs)
+	if database.BackupInterval != "" {
+		state.BackupInterval = types.StringValue(database.BackupInterval)
+	}
+	if database.BackupCopies != 0 {
+		state.BackupCopies = types.Int64Value(int64(database.BackupCopies))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -270,20 +318,14 @@ func (r *pgsqlDatabaseResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	databaseID := int(plan.ID.ValueInt64())
-
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	databaseID := int(plan.ID.ValueInt64())
+
 	database := &client.Database{
 		DatabaseName:   plan.DatabaseName.ValueString(),
 		ParentDomainID: client.FlexInt(plan.ParentDomainID.ValueInt64()),
@@ -317,6 +359,14 @@ func (r *pgsqlDatabaseResource) Update(ctx context.Context, req resource.UpdateR
 	if !plan.RemoteIPs.IsNull() {
 		database.RemoteIPs = plan.RemoteIPs.ValueString()
 	}
+	// Only send the backup settings set in the configuration; the plan holds
+	// the state value otherwise.
+	var config pgsqlDatabaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	database.BackupInterval, database.BackupCopies = backupSettings(config.BackupInterval, config.BackupCopies)
 
 	err := r.client.UpdateDatabase(ctx, databaseID, clientID, database)
 	if err != nil {
@@ -356,8 +406,16 @@ func (r *pgsqlDatabaseResource) Update(ctx context.Context, req resource.UpdateR
 	if plan.RemoteIPs.IsNull() || plan.RemoteIPs.IsUnknown() {
 		plan.RemoteIPs = types.StringValue(updatedDB.RemoteIPs)
 	}
+	if plan.BackupInterval.IsNull() || plan.BackupInterval.IsUnknown() {
+		plan.BackupInterval = types.StringValue(updatedDB.BackupInterval)
+	}
+	if plan.BackupCopies.IsNull() || plan.BackupCopies.IsUnknown() {
+		plan.BackupCopies = types.Int64Value(int64(updatedDB.BackupCopies))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *pgsqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -367,6 +425,12 @@ func (r *pgsqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	databaseID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteDatabase(ctx, databaseID)
@@ -379,12 +443,16 @@ func (r *pgsqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	tflog.Trace(ctx, "Deleted PostgreSQL database", map[string]interface{}{"id": databaseID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
-func (r *pgsqlDatabaseResource) MoveState(_ context.Context) []resource.StateMover {
+func (r *pgsqlDatabaseResource) MoveState(ctx context.Context) []resource.StateMover {
 	return []resource.StateMover{
 		{
-			SourceSchema: webDatabaseSourceSchema(),
+			SourceSchema: webDatabaseSourceSchema(ctx),
 			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
 				if req.SourceTypeName != "ispconfig_web_database" {
 					return
@@ -407,6 +475,7 @@ func (r *pgsqlDatabaseResource) MoveState(_ context.Context) []resource.StateMov
 					ServerID:       src.ServerID,
 					RemoteAccess:   src.RemoteAccess,
 					RemoteIPs:      src.RemoteIPs,
+					Timeouts:       src.Timeouts,
 				}
 				resp.Diagnostics.Append(resp.TargetState.Set(ctx, target)...)
 			},
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +22,7 @@ var (
 	_ resource.ResourceWithConfigure   = &pgsqlDatabaseUserResource{}
 	_ resource.ResourceWithImportState = &pgsqlDatabaseUserResource{}
 	_ resource.ResourceWithMoveState   = &pgsqlDatabaseUserResource{}
+	_ resource.ResourceWithModifyPlan  = &pgsqlDatabaseUserResource{}
 )
 
 func NewPgSQLDatabaseUserResource() resource.Resource {
@@ -28,9 +30,7 @@ func NewPgSQLDatabaseUserResource() resource.Resource {
 }
 
 type pgsqlDatabaseUserResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 type pgsqlDatabaseUserResourceModel struct {
@@ -39,13 +39,15 @@ type pgsqlDatabaseUserResourceModel struct {
 	DatabaseUser     types.String `tfsdk:"database_user"`
 	DatabasePassword types.String `tfsdk:"database_password"`
 	ServerID         types.Int64  `tfsdk:"server_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *pgsqlDatabaseUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_pgsql_database_user"
 }
 
-func (r *pgsqlDatabaseUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *pgsqlDatabaseUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a PostgreSQL database user in ISP Config.",
 		Attributes: map[string]schema.Attribute{
@@ -75,6 +77,9 @@ func (r *pgsqlDatabaseUserResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -92,9 +97,7 @@ func (r *pgsqlDatabaseUserResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
 }
 
 func (r *pgsqlDatabaseUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -104,15 +107,9 @@ func (r *pgsqlDatabaseUserResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -157,6 +154,8 @@ func (r *pgsqlDatabaseUserResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *pgsqlDatabaseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -195,20 +194,14 @@ func (r *pgsqlDatabaseUserResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	dbUserID := int(plan.ID.ValueInt64())
-
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	dbUserID := int(plan.ID.ValueInt64())
+
 	dbUser := &client.DatabaseUser{
 		DatabaseUser:     plan.DatabaseUser.ValueString(),
 		DatabasePassword: plan.DatabasePassword.ValueString(),
@@ -249,6 +242,8 @@ func (r *pgsqlDatabaseUserResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 func (r *pgsqlDatabaseUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -258,6 +253,12 @@ func (r *pgsqlDatabaseUserResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dbUserID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteDatabaseUser(ctx, dbUserID)
@@ -270,12 +271,16 @@ func (r *pgsqlDatabaseUserResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	tflog.Trace(ctx, "Deleted PostgreSQL database user", map[string]interface{}{"id": dbUserID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
-func (r *pgsqlDatabaseUserResource) MoveState(_ context.Context) []resource.StateMover {
+func (r *pgsqlDatabaseUserResource) MoveState(ctx context.Context) []resource.StateMover {
 	return []resource.StateMover{
 		{
-			SourceSchema: webDatabaseUserSourceSchema(),
+			SourceSchema: webDatabaseUserSourceSchema(ctx),
 			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
 				if req.SourceTypeName != "ispconfig_web_database_user" {
 					return
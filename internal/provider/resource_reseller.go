@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &resellerResource{}
+	_ resource.ResourceWithConfigure      = &resellerResource{}
+	_ resource.ResourceWithImportState    = &resellerResource{}
+	_ resource.ResourceWithValidateConfig = &resellerResource{}
+	_ resource.ResourceWithModifyPlan     = &resellerResource{}
+)
+
+// NewResellerResource is a helper function to simplify the provider implementation.
+func NewResellerResource() resource.Resource {
+	return &resellerResource{}
+}
+
+// resellerResource is the resource implementation.
+type resellerResource struct {
+	client *client.Client
+}
+
+// resellerResourceModel maps the resource schema data.
+type resellerResourceModel struct {
+	clientBaseModel
+	LimitClient types.Int64 `tfsdk:"limit_client"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *resellerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reseller"
+}
+
+// Schema defines the schema for the resource.
+func (r *resellerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := clientBaseAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Description: "The ID of the reseller. Use it as reseller_id of the provider or parent_client_id of ispconfig_client.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["limit_client"] = schema.Int64Attribute{
+		Description: "Maximum number of clients the reseller may create (-1 for unlimited). Must not be 0, which would make it a plain client.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an ISP Config reseller: a client that can create and manage clients of its own.",
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resellerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// apiClient builds the API record from the plan.
+func (m resellerResourceModel) apiClient() *client.ISPConfigClient {
+	ispClient := m.clientBaseModel.apiClient()
+	ispClient.LimitClient = optionalFlexInt(m.LimitClient)
+	return ispClient
+}
+
+// setAPIClient copies the API record into the model.
+func (m *resellerResourceModel) setAPIClient(ispClient *client.ISPConfigClient) {
+	m.clientBaseModel.setAPIClient(ispClient)
+	m.LimitClient = flexIntValue(ispClient.LimitClient)
+}
+
+// ValidateConfig checks limit_client and rejects limits next to a master
+// template.
+func (r *resellerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var limitClient types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("limit_client"), &limitClient)...)
+	if !limitClient.IsNull() && !limitClient.IsUnknown() && (limitClient.ValueInt64() == 0 || limitClient.ValueInt64() < -1) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit_client"),
+			"Invalid Client Limit",
+			"limit_client must be -1 (unlimited) or a positive number of clients.",
+		)
+	}
+
+	validateClientLimits(ctx, req, resp)
+}
+
+// ModifyPlan marks the limits that are not configured as unknown when the
+// templates change.
+func (r *resellerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planClientLimits(ctx, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *resellerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resellerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	password, err := writeOnlyString(ctx, req.Config, "password")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading password", err.Error())
+		return
+	}
+	ispClient := plan.apiClient()
+	ispClient.Password = password
+
+	// Create reseller; a non-zero limit_client makes ISP Config use the
+	// reseller form
+	resellerID, err := r.client.AddClient(ctx, ispClient, 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating reseller",
+			"Could not create reseller, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created reseller", map[string]interface{}{"id": resellerID, "username": plan.Username.ValueString()})
+
+	plan.ID = types.Int64Value(int64(resellerID))
+
+	if err := syncAdditionalTemplates(ctx, r.client, resellerID, plan.TemplateAdditional); err != nil {
+		resp.Diagnostics.AddError(
+			"Error assigning client templates",
+			fmt.Sprintf("Could not assign additional templates to reseller ID %d: %s", resellerID, err.Error()),
+		)
+		// Save the reseller so it is tainted rather than orphaned
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	createdReseller, err := r.client.GetClient(ctx, resellerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created reseller",
+			fmt.Sprintf("Could not read reseller ID %d after creation: %s", resellerID, err.Error()),
+		)
+		return
+	}
+	plan.setAPIClient(createdReseller)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, types.Int64Null())...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *resellerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resellerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resellerID := int(state.ID.ValueInt64())
+
+	reseller, err := r.client.GetClient(ctx, resellerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading reseller",
+			fmt.Sprintf("Could not read reseller ID %d: %s", resellerID, err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	state.setAPIClient(reseller)
+	state.TemplateAdditional, err = readAdditionalTemplates(ctx, r.client, resellerID, state.TemplateAdditional)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading client templates",
+			fmt.Sprintf("Could not read additional templates of reseller ID %d: %s", resellerID, err.Error()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *resellerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resellerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	resellerID := int(plan.ID.ValueInt64())
+
+	password, err := writeOnlyString(ctx, req.Config, "password")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading password", err.Error())
+		return
+	}
+	ispClient := plan.apiClient()
+	ispClient.Password = password
+
+	err = r.client.UpdateClient(ctx, resellerID, 0, ispClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating reseller",
+			fmt.Sprintf("Could not update reseller ID %d: %s", resellerID, err.Error()),
+		)
+		return
+	}
+
+	if err := syncAdditionalTemplates(ctx, r.client, resellerID, plan.TemplateAdditional); err != nil {
+		resp.Diagnostics.AddError(
+			"Error assigning client templates",
+			fmt.Sprintf("Could not update additional templates of reseller ID %d: %s", resellerID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated reseller", map[string]interface{}{"id": resellerID})
+
+	updatedReseller, err := r.client.GetClient(ctx, resellerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading updated reseller",
+			fmt.Sprintf("Could not read reseller ID %d after update: %s", resellerID, err.Error()),
+		)
+		return
+	}
+	plan.setAPIClient(updatedReseller)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, types.Int64Null())...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *resellerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resellerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	resellerID := int(state.ID.ValueInt64())
+
+	err := r.client.DeleteClient(ctx, resellerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting reseller",
+			fmt.Sprintf("Could not delete reseller ID %d: %s", resellerID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted reseller", map[string]interface{}{"id": resellerID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, types.Int64Null())...)
+}
+
+// ImportState imports the resource state.
+func (r *resellerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert the import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
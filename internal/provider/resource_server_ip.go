@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &serverIPResource{}
+	_ resource.ResourceWithConfigure      = &serverIPResource{}
+	_ resource.ResourceWithImportState    = &serverIPResource{}
+	_ resource.ResourceWithValidateConfig = &serverIPResource{}
+	_ resource.ResourceWithModifyPlan     = &serverIPResource{}
+)
+
+// NewServerIPResource is a helper function to simplify the provider implementation.
+func NewServerIPResource() resource.Resource {
+	return &serverIPResource{}
+}
+
+// serverIPResource is the resource implementation.
+type serverIPResource struct {
+	clientScope
+}
+
+// serverIPResourceModel maps the resource schema data.
+type serverIPResourceModel struct {
+	serverIPModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *serverIPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_ip"
+}
+
+// Schema defines the schema for the resource.
+func (r *serverIPResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an IP address of an ISP Config server. Web sites can only use the addresses configured on their server.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the server IP.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "The ID of the server. Defaults to the provider server_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.Int64Attribute{
+				Description: "The ID of the client the IP address is reserved for. Defaults to 0, which makes it available to all clients.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "The IPv4 or IPv6 address.",
+				Required:    true,
+			},
+			"ip_type": schema.StringAttribute{
+				Description: "The IP version, 'IPv4' or 'IPv6', derived from ip_address.",
+				Computed:    true,
+			},
+			"virtualhost": schema.BoolAttribute{
+				Description: "Whether web sites may use the IP address (HTTP NameVirtualHost). Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"virtualhost_port": schema.StringAttribute{
+				Description: "The comma-separated ports web sites listen on at the IP address. Defaults to '80,443'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("80,443"),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serverIPResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.configure(providerData)
+}
+
+// ValidateConfig checks the format of ip_address and virtualhost_port.
+func (r *serverIPResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ipAddress, ports types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("virtualhost_port"), &ports)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !ipAddress.IsNull() && !ipAddress.IsUnknown() {
+		if _, err := serverIPType(ipAddress.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ip_address"), "Invalid IP Address", err.Error())
+		}
+	}
+	if !ports.IsNull() && !ports.IsUnknown() {
+		if err := validatePortList(ports.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("virtualhost_port"), "Invalid Ports", err.Error())
+		}
+	}
+}
+
+// ModifyPlan derives ip_type from a known ip_address and checks that a known
+// client_id belongs to the reseller configured in the provider.
+func (r *serverIPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planClientID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("client_id"), &planClientID)...)
+	if clientID := int(planClientID.ValueInt64()); !planClientID.IsUnknown() && clientID != 0 {
+		resp.Diagnostics.Append(r.ownedClients.check(ctx, r.client, r.resellerID, clientID)...)
+	}
+
+	var ipAddress types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+	if resp.Diagnostics.HasError() || ipAddress.IsUnknown() {
+		return
+	}
+
+	if ipType, err := serverIPType(ipAddress.ValueString()); err == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ip_type"), ipType)...)
+	}
+}
+
+// apiServerIP builds the API record from the plan and resolves the server ID.
+func (r *serverIPResource) apiServerIP(plan *serverIPModel) (*client.ServerIP, error) {
+	serverID := r.serverID
+	if !plan.ServerID.IsNull() && !plan.ServerID.IsUnknown() {
+		serverID = int(plan.ServerID.ValueInt64())
+	}
+	if serverID == 0 {
+		return nil, fmt.Errorf("server ID must be set either in the provider configuration or in the resource configuration")
+	}
+	ipType, err := serverIPType(plan.IPAddress.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	plan.ServerID = types.Int64Value(int64(serverID))
+	plan.IPType = types.StringValue(ipType)
+	return &client.ServerIP{
+		ServerID:        client.FlexInt(serverID),
+		ClientID:        client.FlexInt(plan.ClientID.ValueInt64()),
+		IPType:          ipType,
+		IPAddress:       plan.IPAddress.ValueString(),
+		VirtualHost:     boolToYN(plan.VirtualHost.ValueBool()),
+		VirtualHostPort: plan.VirtualHostPort.ValueString(),
+	}, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serverIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverIPResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	if clientID := int(plan.ClientID.ValueInt64()); clientID != 0 {
+		resp.Diagnostics.Append(r.ownedClients.check(ctx, r.client, r.resellerID, clientID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ip, err := r.apiServerIP(&plan.serverIPModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Server IP", err.Error())
+		return
+	}
+
+	ipID, err := r.client.AddServerIP(ctx, ip, 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating server IP",
+			"Could not create server IP, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created server IP", map[string]interface{}{"id": ipID})
+	plan.ID = types.Int64Value(int64(ipID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serverIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverIPResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipID := int(state.ID.ValueInt64())
+
+	ip, err := r.client.GetServerIP(ctx, ipID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading server IP",
+			fmt.Sprintf("Could not read server IP ID %d: %s", ipID, err.Error()),
+		)
+		return
+	}
+
+	state.serverIPModel = newServerIPModel(*ip)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serverIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverIPResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	ipID := int(plan.ID.ValueInt64())
+
+	if clientID := int(plan.ClientID.ValueInt64()); clientID != 0 {
+		resp.Diagnostics.Append(r.ownedClients.check(ctx, r.client, r.resellerID, clientID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ip, err := r.apiServerIP(&plan.serverIPModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Server IP", err.Error())
+		return
+	}
+
+	err = r.client.UpdateServerIP(ctx, ipID, 0, ip)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating server IP",
+			fmt.Sprintf("Could not update server IP ID %d: %s", ipID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated server IP", map[string]interface{}{"id": ipID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serverIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverIPResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if clientID := int(state.ClientID.ValueInt64()); clientID != 0 {
+		resp.Diagnostics.Append(r.ownedClients.check(ctx, r.client, r.resellerID, clientID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ipID := int(state.ID.ValueInt64())
+
+	err := r.client.DeleteServerIP(ctx, ipID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting server IP",
+			fmt.Sprintf("Could not delete server IP ID %d: %s", ipID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted server IP", map[string]interface{}{"id": ipID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
+}
+
+// ImportState imports the resource state.
+func (r *serverIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,10 +21,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &webDatabaseResource{}
-	_ resource.ResourceWithConfigure   = &webDatabaseResource{}
-	_ resource.ResourceWithImportState = &webDatabaseResource{}
+	_ resource.Resource                 = &webDatabaseResource{}
+	_ resource.ResourceWithConfigure    = &webDatabaseResource{}
+	_ resource.ResourceWithImportState  = &webDatabaseResource{}
 	_ resource.ResourceWithUpgradeState = &webDatabaseResource{}
+	_ resource.ResourceWithModifyPlan   = &webDatabaseResource{}
 )
 
 // NewWebDatabaseResource is a helper function to simplify the provider implementation.
@@ -33,9 +35,7 @@ func NewWebDatabaseResource() resource.Resource {
 
 // webDatabaseResource is the resource implementation.
 type webDatabaseResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 // webDatabaseResourceModel maps the resource schema data.
@@ -51,6 +51,8 @@ type webDatabaseResourceModel struct {
 	ServerID       types.Int64  `tfsdk:"server_id"`
 	RemoteAccess   types.Bool   `tfsdk:"remote_access"`
 	RemoteIPs      types.String `tfsdk:"remote_ips"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // webDatabaseResourceModelV0 represents the old state model with string active and remote_access attributes
@@ -74,10 +76,11 @@ func (r *webDatabaseResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *webDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *webDatabaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:     1,
-		Description: "Manages a database in ISP Config. Deprecated: use `ispconfig_mysql_database` or `ispconfig_pgsql_database` instead.",
+		Description: "Manages a database in ISP Config. Deprecated: use `ispconfig_mysql_database` or `ispconfig_pgsql_database` instead. " +
+			"Planning a new database fails when the client has used up limit_database; databases created in the same plan are not counted.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "The ID of the database.",
@@ -137,6 +140,9 @@ func (r *webDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -155,9 +161,14 @@ func (r *webDatabaseResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
+}
+
+// ModifyPlan checks that the client belongs to the configured reseller, and
+// limit_database of the owning client when the resource is created.
+func (r *webDatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.clientScope.ModifyPlan(ctx, req, resp)
+	checkClientLimit(ctx, r.client, r.clientID, "limit_database", req, resp)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -169,17 +180,9 @@ func (r *webDatabaseResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -269,6 +272,8 @@ func (r *webDatabaseResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -323,22 +328,14 @@ func (r *webDatabaseResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	databaseID := int(plan.ID.ValueInt64())
-
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	databaseID := int(plan.ID.ValueInt64())
+
 	// Build Database struct
 	database := &client.Database{
 		DatabaseName:   plan.DatabaseName.ValueString(),
@@ -423,6 +420,8 @@ func (r *webDatabaseResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -434,6 +433,12 @@ func (r *webDatabaseResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	databaseID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteDatabase(ctx, databaseID)
@@ -446,6 +451,10 @@ func (r *webDatabaseResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	tflog.Trace(ctx, "Deleted database", map[string]interface{}{"id": databaseID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
 // ImportState imports the resource state.
@@ -465,7 +474,7 @@ func (r *webDatabaseResource) ImportState(ctx context.Context, req resource.Impo
 
 // webDatabaseSourceSchema returns the current (v1) web_database schema for use in MoveState movers.
 // It omits defaults since they are not needed for source state decoding.
-func webDatabaseSourceSchema() *schema.Schema {
+func webDatabaseSourceSchema(ctx context.Context) *schema.Schema {
 	return &schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id":               schema.Int64Attribute{Computed: true},
@@ -480,6 +489,9 @@ func webDatabaseSourceSchema() *schema.Schema {
 			"remote_access":    schema.BoolAttribute{Optional: true, Computed: true},
 			"remote_ips":       schema.StringAttribute{Optional: true, Computed: true},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -577,6 +589,7 @@ func (r *webDatabaseResource) UpgradeState(ctx context.Context) map[int64]resour
 					newState.RemoteAccess = types.BoolValue(false) // default
 				}
 				newState.RemoteIPs = oldState.RemoteIPs
+				newState.Timeouts = nullTimeouts()
 
 				// Set the upgraded state
 				resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +22,7 @@ var (
 	_ resource.Resource                = &webDatabaseUserResource{}
 	_ resource.ResourceWithConfigure   = &webDatabaseUserResource{}
 	_ resource.ResourceWithImportState = &webDatabaseUserResource{}
+	_ resource.ResourceWithModifyPlan  = &webDatabaseUserResource{}
 )
 
 // NewWebDatabaseUserResource is a helper function to simplify the provider implementation.
@@ -30,9 +32,7 @@ func NewWebDatabaseUserResource() resource.Resource {
 
 // webDatabaseUserResource is the resource implementation.
 type webDatabaseUserResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 // webDatabaseUserResourceModel maps the resource schema data.
@@ -42,6 +42,8 @@ type webDatabaseUserResourceModel struct {
 	DatabaseUser     types.String `tfsdk:"database_user"`
 	DatabasePassword types.String `tfsdk:"database_password"`
 	ServerID         types.Int64  `tfsdk:"server_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -50,7 +52,7 @@ func (r *webDatabaseUserResource) Metadata(_ context.Context, req resource.Metad
 }
 
 // Schema defines the schema for the resource.
-func (r *webDatabaseUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *webDatabaseUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a database user in ISP Config. Deprecated: use `ispconfig_mysql_database_user` or `ispconfig_pgsql_database_user` instead.",
 		Attributes: map[string]schema.Attribute{
@@ -80,6 +82,9 @@ func (r *webDatabaseUserResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -98,9 +103,7 @@ func (r *webDatabaseUserResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -112,17 +115,9 @@ func (r *webDatabaseUserResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -170,6 +165,8 @@ func (r *webDatabaseUserResource) Create(ctx context.Context, req resource.Creat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -214,22 +211,14 @@ func (r *webDatabaseUserResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	dbUserID := int(plan.ID.ValueInt64())
-
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	dbUserID := int(plan.ID.ValueInt64())
+
 	// Build DatabaseUser struct
 	dbUser := &client.DatabaseUser{
 		DatabaseUser:     plan.DatabaseUser.ValueString(),
@@ -272,6 +261,8 @@ func (r *webDatabaseUserResource) Update(ctx context.Context, req resource.Updat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -283,6 +274,12 @@ func (r *webDatabaseUserResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dbUserID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteDatabaseUser(ctx, dbUserID)
@@ -295,10 +292,14 @@ func (r *webDatabaseUserResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	tflog.Trace(ctx, "Deleted database user", map[string]interface{}{"id": dbUserID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
 // webDatabaseUserSourceSchema returns the web_database_user schema for use in MoveState movers.
-func webDatabaseUserSourceSchema() *schema.Schema {
+func webDatabaseUserSourceSchema(ctx context.Context) *schema.Schema {
 	return &schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id":                schema.Int64Attribute{Computed: true},
@@ -307,6 +308,9 @@ func webDatabaseUserSourceSchema() *schema.Schema {
 			"database_password": schema.StringAttribute{Optional: true, Computed: true, Sensitive: true},
 			"server_id":        schema.Int64Attribute{Optional: true, Computed: true},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
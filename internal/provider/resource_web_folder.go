@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webFolderResource{}
+	_ resource.ResourceWithConfigure   = &webFolderResource{}
+	_ resource.ResourceWithImportState = &webFolderResource{}
+	_ resource.ResourceWithModifyPlan  = &webFolderResource{}
+)
+
+// NewWebFolderResource is a helper function to simplify the provider implementation.
+func NewWebFolderResource() resource.Resource {
+	return &webFolderResource{}
+}
+
+// webFolderResource is the resource implementation.
+type webFolderResource struct {
+	clientScope
+}
+
+// webFolderResourceModel maps the resource schema data.
+type webFolderResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	ClientID       types.Int64  `tfsdk:"client_id"`
+	ParentDomainID types.Int64  `tfsdk:"parent_domain_id"`
+	Path           types.String `tfsdk:"path"`
+	Active         types.Bool   `tfsdk:"active"`
+	ServerID       types.Int64  `tfsdk:"server_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *webFolderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_folder"
+}
+
+// Schema defines the schema for the resource.
+func (r *webFolderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a password protected folder (HTTP basic auth) of a web domain in ISP Config.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the protected folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.Int64Attribute{
+				Description: "The ISP Config client ID.",
+				Optional:    true,
+			},
+			"parent_domain_id": schema.Int64Attribute{
+				Description: "The ID of the web hosting domain the folder belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "The folder path relative to the web root of the parent domain (e.g. '/' to protect the whole site).",
+				Required:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the folder protection is active.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "The server ID. Defaults to the server of the parent domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webFolderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.configure(providerData)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webFolderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webFolder := &client.WebFolder{
+		ParentDomainID: client.FlexInt(plan.ParentDomainID.ValueInt64()),
+		Path:           plan.Path.ValueString(),
+		Active:         boolToYN(plan.Active.ValueBool()),
+	}
+
+	serverID, err := r.resolveServerID(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching parent domain",
+			"Could not fetch parent domain to determine the server: "+err.Error(),
+		)
+		return
+	}
+	webFolder.ServerID = client.FlexInt(serverID)
+	plan.ServerID = types.Int64Value(int64(serverID))
+
+	// Create web folder
+	webFolderID, err := r.client.AddWebFolder(ctx, webFolder, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating web folder",
+			"Could not create web folder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created web folder", map[string]interface{}{"id": webFolderID, "path": plan.Path.ValueString()})
+
+	plan.ID = types.Int64Value(int64(webFolderID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// resolveServerID returns the configured server ID, falling back to the
+// parent domain's server and then to the provider default.
+func (r *webFolderResource) resolveServerID(ctx context.Context, plan webFolderResourceModel) (int, error) {
+	if !plan.ServerID.IsNull() && !plan.ServerID.IsUnknown() {
+		return int(plan.ServerID.ValueInt64()), nil
+	}
+
+	parentDomain, err := r.client.GetWebDomain(ctx, int(plan.ParentDomainID.ValueInt64()))
+	if err != nil {
+		return 0, err
+	}
+	if parentDomain.ServerID != 0 {
+		return int(parentDomain.ServerID), nil
+	}
+	return r.serverID, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webFolderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webFolderID := int(state.ID.ValueInt64())
+
+	webFolder, err := r.client.GetWebFolder(ctx, webFolderID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading web folder",
+			fmt.Sprintf("Could not read web folder ID %d: %s", webFolderID, err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	state.ParentDomainID = types.Int64Value(int64(webFolder.ParentDomainID))
+	state.Path = types.StringValue(webFolder.Path)
+	state.Active = types.BoolValue(ynToBool(webFolder.Active))
+	if webFolder.ServerID != 0 {
+		state.ServerID = types.Int64Value(int64(webFolder.ServerID))
+	} else if r.serverID != 0 {
+		state.ServerID = types.Int64Value(int64(r.serverID))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webFolderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webFolderID := int(plan.ID.ValueInt64())
+
+	webFolder := &client.WebFolder{
+		ParentDomainID: client.FlexInt(plan.ParentDomainID.ValueInt64()),
+		Path:           plan.Path.ValueString(),
+		Active:         boolToYN(plan.Active.ValueBool()),
+	}
+
+	serverID, err := r.resolveServerID(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching parent domain",
+			"Could not fetch parent domain to determine the server: "+err.Error(),
+		)
+		return
+	}
+	webFolder.ServerID = client.FlexInt(serverID)
+	plan.ServerID = types.Int64Value(int64(serverID))
+
+	// Update web folder
+	err = r.client.UpdateWebFolder(ctx, webFolderID, clientID, webFolder)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating web folder",
+			fmt.Sprintf("Could not update web folder ID %d: %s", webFolderID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated web folder", map[string]interface{}{"id": webFolderID})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webFolderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webFolderID := int(state.ID.ValueInt64())
+
+	err := r.client.DeleteWebFolder(ctx, webFolderID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting web folder",
+			fmt.Sprintf("Could not delete web folder ID %d: %s", webFolderID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted web folder", map[string]interface{}{"id": webFolderID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
+}
+
+// ImportState imports the resource state.
+func (r *webFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert the import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webFolderUserResource{}
+	_ resource.ResourceWithConfigure   = &webFolderUserResource{}
+	_ resource.ResourceWithImportState = &webFolderUserResource{}
+	_ resource.ResourceWithModifyPlan  = &webFolderUserResource{}
+)
+
+// NewWebFolderUserResource is a helper function to simplify the provider implementation.
+func NewWebFolderUserResource() resource.Resource {
+	return &webFolderUserResource{}
+}
+
+// webFolderUserResource is the resource implementation.
+type webFolderUserResource struct {
+	clientScope
+}
+
+// webFolderUserResourceModel maps the resource schema data.
+type webFolderUserResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	ClientID        types.Int64  `tfsdk:"client_id"`
+	WebFolderID     types.Int64  `tfsdk:"web_folder_id"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Active          types.Bool   `tfsdk:"active"`
+	ServerID        types.Int64  `tfsdk:"server_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *webFolderUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_folder_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *webFolderUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a basic auth user of a protected web folder in ISP Config.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the folder user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.Int64Attribute{
+				Description: "The ISP Config client ID.",
+				Optional:    true,
+			},
+			"web_folder_id": schema.Int64Attribute{
+				Description: "The ID of the protected folder (ispconfig_web_folder) the user belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The basic auth username. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The basic auth password. This value is write-only and never stored in the Terraform state " +
+					"(requires Terraform 1.11 or later). Change password_version to apply a new password.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_version": schema.Int64Attribute{
+				Description: "An arbitrary version number for the password. Changing it sends the current password to ISP Config.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the folder user is active.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "The server ID. Defaults to the server of the protected folder.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webFolderUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.configure(providerData)
+}
+
+// writeOnlyString returns the value of a write-only attribute. Write-only
+// values are only available in the configuration, never in the plan or state.
+func writeOnlyString(ctx context.Context, config tfsdk.Config, name string) (string, error) {
+	var value types.String
+	if diags := config.GetAttribute(ctx, path.Root(name), &value); diags.HasError() {
+		return "", fmt.Errorf("could not read %s from configuration", name)
+	}
+	return value.ValueString(), nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webFolderUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webFolderUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, err := writeOnlyString(ctx, req.Config, "password")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading password", err.Error())
+		return
+	}
+
+	webFolderUser := &client.WebFolderUser{
+		WebFolderID: client.FlexInt(plan.WebFolderID.ValueInt64()),
+		Username:    plan.Username.ValueString(),
+		Password:    password,
+		Active:      boolToYN(plan.Active.ValueBool()),
+	}
+
+	serverID, err := r.resolveServerID(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching web folder",
+			"Could not fetch web folder to determine the server: "+err.Error(),
+		)
+		return
+	}
+	webFolderUser.ServerID = client.FlexInt(serverID)
+	plan.ServerID = types.Int64Value(int64(serverID))
+
+	// Create web folder user
+	webFolderUserID, err := r.client.AddWebFolderUser(ctx, webFolderUser, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating web folder user",
+			"Could not create web folder user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created web folder user", map[string]interface{}{"id": webFolderUserID, "username": plan.Username.ValueString()})
+
+	plan.ID = types.Int64Value(int64(webFolderUserID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// resolveServerID returns the configured server ID, falling back to the
+// protected folder's server and then to the provider default.
+func (r *webFolderUserResource) resolveServerID(ctx context.Context, plan webFolderUserResourceModel) (int, error) {
+	if !plan.ServerID.IsNull() && !plan.ServerID.IsUnknown() {
+		return int(plan.ServerID.ValueInt64()), nil
+	}
+
+	webFolder, err := r.client.GetWebFolder(ctx, int(plan.WebFolderID.ValueInt64()))
+	if err != nil {
+		return 0, err
+	}
+	if webFolder.ServerID != 0 {
+		return int(webFolder.ServerID), nil
+	}
+	return r.serverID, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webFolderUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webFolderUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webFolderUserID := int(state.ID.ValueInt64())
+
+	webFolderUser, err := r.client.GetWebFolderUser(ctx, webFolderUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading web folder user",
+			fmt.Sprintf("Could not read web folder user ID %d: %s", webFolderUserID, err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	state.WebFolderID = types.Int64Value(int64(webFolderUser.WebFolderID))
+	state.Username = types.StringValue(webFolderUser.Username)
+	state.Active = types.BoolValue(ynToBool(webFolderUser.Active))
+	if webFolderUser.ServerID != 0 {
+		state.ServerID = types.Int64Value(int64(webFolderUser.ServerID))
+	} else if r.serverID != 0 {
+		state.ServerID = types.Int64Value(int64(r.serverID))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webFolderUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webFolderUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webFolderUserID := int(plan.ID.ValueInt64())
+
+	password, err := writeOnlyString(ctx, req.Config, "password")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading password", err.Error())
+		return
+	}
+
+	webFolderUser := &client.WebFolderUser{
+		WebFolderID: client.FlexInt(plan.WebFolderID.ValueInt64()),
+		Username:    plan.Username.ValueString(),
+		Password:    password,
+		Active:      boolToYN(plan.Active.ValueBool()),
+	}
+
+	serverID, err := r.resolveServerID(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching web folder",
+			"Could not fetch web folder to determine the server: "+err.Error(),
+		)
+		return
+	}
+	webFolderUser.ServerID = client.FlexInt(serverID)
+	plan.ServerID = types.Int64Value(int64(serverID))
+
+	// Update web folder user
+	err = r.client.UpdateWebFolderUser(ctx, webFolderUserID, clientID, webFolderUser)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating web folder user",
+			fmt.Sprintf("Could not update web folder user ID %d: %s", webFolderUserID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated web folder user", map[string]interface{}{"id": webFolderUserID})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webFolderUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webFolderUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webFolderUserID := int(state.ID.ValueInt64())
+
+	err := r.client.DeleteWebFolderUser(ctx, webFolderUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting web folder user",
+			fmt.Sprintf("Could not delete web folder user ID %d: %s", webFolderUserID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted web folder user", map[string]interface{}{"id": webFolderUserID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
+}
+
+// ImportState imports the resource state. The password is write-only and
+// is sent again on the next apply that changes the resource.
+func (r *webFolderUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert the import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
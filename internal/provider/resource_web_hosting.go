@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	filepath "path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -23,9 +29,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &webHostingResource{}
-	_ resource.ResourceWithConfigure   = &webHostingResource{}
-	_ resource.ResourceWithImportState = &webHostingResource{}
+	_ resource.Resource                   = &webHostingResource{}
+	_ resource.ResourceWithConfigure      = &webHostingResource{}
+	_ resource.ResourceWithImportState    = &webHostingResource{}
+	_ resource.ResourceWithValidateConfig = &webHostingResource{}
+	_ resource.ResourceWithModifyPlan     = &webHostingResource{}
 )
 
 // NewWebHostingResource is a helper function to simplify the provider implementation.
@@ -35,10 +43,8 @@ func NewWebHostingResource() resource.Resource {
 
 // webHostingResource is the resource implementation.
 type webHostingResource struct {
-	client     *client.Client
-	clientID   int
-	serverID   int
-	phpVersions map[string]string // cached: "8.4" -> "PHP 8.4:/etc/init.d/php8.4-fpm:..."
+	clientScope
+	phpVersions *phpVersionCache
 }
 
 // webHostingResourceModel maps the resource schema data.
@@ -65,6 +71,13 @@ type webHostingResourceModel struct {
 	Python                 types.Bool   `tfsdk:"python"`
 	SuExec                 types.Bool   `tfsdk:"suexec"`
 	SSL                    types.Bool   `tfsdk:"ssl"`
+	SSLLetsencrypt         types.Bool   `tfsdk:"ssl_letsencrypt"`
+	WaitForSSLCertificate  types.Bool   `tfsdk:"wait_for_ssl_certificate"`
+	SSLCertExpiry          types.String `tfsdk:"ssl_cert_expiry"`
+	SSLCertFingerprint     types.String `tfsdk:"ssl_cert_fingerprint"`
+	SSLCertificate         types.String `tfsdk:"ssl_certificate"`
+	SSLChain               types.String `tfsdk:"ssl_chain"`
+	SSLPrivateKey          types.String `tfsdk:"ssl_private_key"`
 	Subdomain              types.String `tfsdk:"subdomain"`
 	RedirectType           types.String `tfsdk:"redirect_type"`
 	RedirectPath           types.String `tfsdk:"redirect_path"`
@@ -72,43 +85,292 @@ type webHostingResourceModel struct {
 	PM                     types.String `tfsdk:"pm"`
 	PMProcessIdleTimeout   types.String `tfsdk:"pm_process_idle_timeout"`
 	PMMaxRequests          types.Int64  `tfsdk:"pm_max_requests"`
+	PMMaxChildren          types.Int64  `tfsdk:"pm_max_children"`
+	PMStartServers         types.Int64  `tfsdk:"pm_start_servers"`
+	PMMinSpareServers      types.Int64  `tfsdk:"pm_min_spare_servers"`
+	PMMaxSpareServers      types.Int64  `tfsdk:"pm_max_spare_servers"`
+	PHPFPMChroot           types.Bool   `tfsdk:"php_fpm_chroot"`
+	CustomPHPIni           types.Map    `tfsdk:"custom_php_ini"`
+	StatsType              types.String `tfsdk:"stats_type"`
+	StatsPassword          types.String `tfsdk:"stats_password"`
+	BackupInterval         types.String `tfsdk:"backup_interval"`
+	BackupCopies           types.Int64  `tfsdk:"backup_copies"`
 	HTTPPort               types.Int64  `tfsdk:"http_port"`
 	HTTPSPort              types.Int64  `tfsdk:"https_port"`
 	PHPOpenBasedir         types.String `tfsdk:"php_open_basedir"`
 	ApacheDirectives       types.String `tfsdk:"apache_directives"`
+	NginxDirectives        types.String `tfsdk:"nginx_directives"`
+	ProxyDirectives        types.String `tfsdk:"proxy_directives"`
+	ProxyProtocol          types.Bool   `tfsdk:"proxy_protocol"`
 	DisableSymlinkNotOwner types.Bool   `tfsdk:"disable_symlink_restriction"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// phpVersionCache caches the PHP versions available per server and PHP
+// handler. One cache is shared by the whole provider, so plan-time
+// validation and all resource instances fetch each combination only once.
+type phpVersionCache struct {
+	mu      sync.Mutex
+	entries map[phpVersionKey]*phpVersionEntry
+}
+
+// phpVersionKey identifies the PHP versions of a handler on a server.
+type phpVersionKey struct {
+	serverID int
+	handler  string
 }
 
-// ensurePHPVersions fetches PHP versions from the ISPConfig API and caches
-// the version-to-full-string mapping. It is a no-op if already populated.
-func (r *webHostingResource) ensurePHPVersions(ctx context.Context, serverID int, phpType string) error {
-	if r.phpVersions != nil {
-		return nil
+// phpVersionEntry holds the versions of one key. Its own lock makes
+// concurrent callers of the same key wait for a single fetch without
+// blocking the other keys.
+type phpVersionEntry struct {
+	mu       sync.Mutex
+	versions map[string]string // "8.4" -> "PHP 8.4:/etc/init.d/php8.4-fpm:..."
+}
+
+// newPHPVersionCache returns an empty cache.
+func newPHPVersionCache() *phpVersionCache {
+	return &phpVersionCache{entries: map[phpVersionKey]*phpVersionEntry{}}
+}
+
+// get returns the PHP versions of a handler on a server, fetching them from
+// the ISPConfig API on first use. Failed fetches are not cached. A nil cache
+// always fetches.
+func (p *phpVersionCache) get(ctx context.Context, c *client.Client, serverID int, handler string) (map[string]string, error) {
+	if p == nil {
+		p = newPHPVersionCache()
+	}
+
+	key := phpVersionKey{serverID: serverID, handler: handler}
+	p.mu.Lock()
+	entry, ok := p.entries[key]
+	if !ok {
+		entry = &phpVersionEntry{}
+		p.entries[key] = entry
 	}
+	p.mu.Unlock()
 
-	versions, err := r.client.GetPHPVersions(ctx, serverID, phpType)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.versions != nil {
+		return entry.versions, nil
+	}
+
+	versions, err := c.GetPHPVersions(ctx, serverID, handler)
 	if err != nil {
-		return fmt.Errorf("failed to fetch PHP versions from server: %w", err)
+		return nil, fmt.Errorf("failed to fetch PHP versions from server: %w", err)
 	}
 
-	r.phpVersions = versions
-	return nil
+	entry.versions = versions
+	return versions, nil
 }
 
 // phpVersionToFullString converts a short PHP version string (e.g. "8.4") to
 // the full info string required by ISPConfig's fastcgi_php_version field.
-func (r *webHostingResource) phpVersionToFullString(version string) (string, error) {
-	fullStr, ok := r.phpVersions[version]
+func phpVersionToFullString(versions map[string]string, version string) (string, error) {
+	fullStr, ok := versions[version]
 	if !ok {
-		available := make([]string, 0, len(r.phpVersions))
-		for v := range r.phpVersions {
+		available := make([]string, 0, len(versions))
+		for v := range versions {
 			available = append(available, v)
 		}
+		sort.Slice(available, func(i, j int) bool {
+			return comparePHPVersions(available[i], available[j]) < 0
+		})
 		return "", fmt.Errorf("invalid PHP version: %s. Available versions on this server are: %s", version, strings.Join(available, ", "))
 	}
 	return fullStr, nil
 }
 
+// sslCertificatePollInterval is the delay between two certificate checks.
+var sslCertificatePollInterval = 15 * time.Second
+
+// waitForSSLCertificate polls the web domain until ISPConfig reports a
+// certificate, at most until the create or update timeout of ctx. Issuance
+// runs from the server cron, so it usually takes one to three minutes.
+// ISPConfig resets ssl_letsencrypt to 'n' when issuance fails, which is
+// reported as an error instead of waiting for the timeout.
+func waitForSSLCertificate(ctx context.Context, c *client.Client, domainID int) (*client.WebDomain, error) {
+	ticker := time.NewTicker(sslCertificatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		domain, err := c.GetWebDomain(ctx, domainID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, sslCertificateTimeoutError(domainID)
+			}
+			return nil, err
+		}
+		if domain.SSLCert != "" {
+			return domain, nil
+		}
+		if !ynToBool(domain.SSLLetsencrypt) {
+			return nil, fmt.Errorf("ISPConfig disabled Let's Encrypt for domain ID %d, the certificate request failed (see /var/log/ispconfig/acme.log on the web server)", domainID)
+		}
+
+		tflog.Debug(ctx, "Waiting for Let's Encrypt certificate", map[string]interface{}{"id": domainID})
+
+		select {
+		case <-ctx.Done():
+			return nil, sslCertificateTimeoutError(domainID)
+		case <-ticker.C:
+		}
+	}
+}
+
+// sslCertificateTimeoutError reports that the operation timeout ended the
+// wait, whether during a poll or between two polls.
+func sslCertificateTimeoutError(domainID int) error {
+	return fmt.Errorf("timed out waiting for the Let's Encrypt certificate of domain ID %d; raise the create or update timeout if issuance takes longer", domainID)
+}
+
+// setSSLCertificateInfo fills the computed certificate attributes from the
+// PEM certificate stored by ISPConfig. Both are empty without a certificate.
+func setSSLCertificateInfo(ctx context.Context, model *webHostingResourceModel, certPEM string) {
+	model.SSLCertExpiry = types.StringValue("")
+	model.SSLCertFingerprint = types.StringValue("")
+	if certPEM == "" {
+		return
+	}
+
+	expiry, fingerprint, err := certificateInfo(certPEM)
+	if err != nil {
+		tflog.Warn(ctx, "Could not parse SSL certificate", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	model.SSLCertExpiry = types.StringValue(expiry)
+	model.SSLCertFingerprint = types.StringValue(fingerprint)
+}
+
+// hasCustomCertificate reports whether the plan brings its own certificate.
+func (m webHostingResourceModel) hasCustomCertificate() bool {
+	return m.SSLCertificate.ValueString() != "" || m.SSLPrivateKey.ValueString() != "" || m.SSLChain.ValueString() != ""
+}
+
+// setSSLCertificateAction sets the custom certificate and ssl_action of an
+// update. The certificate is only saved when it was rotated, because
+// ssl_action=save rewrites the certificate files and reloads the web server.
+// When the plan drops a custom certificate, ssl_action=del removes it, since
+// omitting the fields would leave the old certificate in place.
+func setSSLCertificateAction(domain *client.WebDomain, plan, state webHostingResourceModel) {
+	switch {
+	case plan.hasCustomCertificate() &&
+		(!plan.SSLCertificate.Equal(state.SSLCertificate) ||
+			!plan.SSLChain.Equal(state.SSLChain) ||
+			!plan.SSLPrivateKey.Equal(state.SSLPrivateKey)):
+		domain.SSLCert = plan.SSLCertificate.ValueString()
+		domain.SSLBundle = plan.SSLChain.ValueString()
+		domain.SSLKey = plan.SSLPrivateKey.ValueString()
+		domain.SSLAction = "save"
+	case !plan.hasCustomCertificate() && state.hasCustomCertificate():
+		domain.SSLAction = "del"
+	}
+}
+
+// validateSSLConfiguration checks the SSL attributes before any API call,
+// including that a custom private key matches its certificate.
+func validateSSLConfiguration(plan webHostingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.SSLLetsencrypt.ValueBool() && !plan.SSL.ValueBool() {
+		diags.AddError(
+			"Conflicting Configuration",
+			"ssl_letsencrypt requires ssl = true.",
+		)
+		return diags
+	}
+
+	if !plan.hasCustomCertificate() {
+		return diags
+	}
+	if plan.SSLCertificate.ValueString() == "" || plan.SSLPrivateKey.ValueString() == "" {
+		diags.AddError(
+			"Incomplete SSL Certificate",
+			"ssl_certificate and ssl_private_key must be set together (ssl_chain is optional).",
+		)
+		return diags
+	}
+	if !plan.SSL.ValueBool() || plan.SSLLetsencrypt.ValueBool() {
+		diags.AddError(
+			"Conflicting Configuration",
+			"A custom ssl_certificate requires ssl = true and cannot be combined with ssl_letsencrypt.",
+		)
+		return diags
+	}
+	if err := validateCertificateKeyPair(plan.SSLCertificate.ValueString(), plan.SSLPrivateKey.ValueString(), plan.SSLChain.ValueString()); err != nil {
+		diags.AddError(
+			"Invalid SSL Certificate",
+			err.Error(),
+		)
+	}
+	return diags
+}
+
+// Web server types as reported in the "web" section of the server config.
+const (
+	webServerApache = "apache"
+	webServerNginx  = "nginx"
+)
+
+// validateWebServerSettings rejects configured settings that the target
+// server's web server would ignore. The server config is only fetched when
+// such a setting is used.
+func (r *webHostingResource) validateWebServerSettings(ctx context.Context, serverID int, config webHostingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apacheDirectives := config.ApacheDirectives.ValueString() != ""
+	nginxDirectives := config.NginxDirectives.ValueString() != ""
+	proxyDirectives := config.ProxyDirectives.ValueString() != ""
+	proxyProtocol := config.ProxyProtocol.ValueBool()
+	if !apacheDirectives && !nginxDirectives && !proxyDirectives && !proxyProtocol {
+		return diags
+	}
+
+	webConfig, err := r.client.GetServerConfig(ctx, serverID, "web")
+	if err != nil {
+		diags.AddError(
+			"Failed to Detect Web Server",
+			fmt.Sprintf("Could not read the web server configuration of server ID %d: %s", serverID, err.Error()),
+		)
+		return diags
+	}
+	serverType := webConfig["server_type"]
+
+	if apacheDirectives && serverType != webServerApache {
+		diags.AddAttributeError(
+			path.Root("apache_directives"),
+			"Setting Not Supported by Web Server",
+			fmt.Sprintf("Server ID %d runs %q, apache_directives would be ignored. Use nginx_directives instead.", serverID, serverType),
+		)
+	}
+	if nginxDirectives && serverType != webServerNginx {
+		diags.AddAttributeError(
+			path.Root("nginx_directives"),
+			"Setting Not Supported by Web Server",
+			fmt.Sprintf("Server ID %d runs %q, nginx_directives would be ignored. Use apache_directives instead.", serverID, serverType),
+		)
+	}
+	if proxyDirectives && serverType != webServerNginx {
+		diags.AddAttributeError(
+			path.Root("proxy_directives"),
+			"Setting Not Supported by Web Server",
+			fmt.Sprintf("Server ID %d runs %q, proxy_directives are only used by nginx.", serverID, serverType),
+		)
+	}
+	// vhost_proxy_protocol_enabled is 'n' (off), 'y' (per website) or 'all'
+	if proxyProtocol && webConfig["vhost_proxy_protocol_enabled"] != "y" && webConfig["vhost_proxy_protocol_enabled"] != "all" {
+		diags.AddAttributeError(
+			path.Root("proxy_protocol"),
+			"Setting Not Supported by Web Server",
+			fmt.Sprintf("PROXY protocol is not enabled in the web configuration of server ID %d, proxy_protocol would be ignored.", serverID),
+		)
+	}
+
+	return diags
+}
+
 // combineDocumentRoot combines a base document root path with a subdirectory
 func combineDocumentRoot(basePath, subdir string) string {
 	if subdir == "" {
@@ -126,9 +388,10 @@ func (r *webHostingResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *webHostingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *webHostingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a web hosting domain in ISP Config.",
+		Description: "Manages a web hosting domain in ISP Config. The plan of a new domain fails when the owning client has used up " +
+			"limit_web_domain; web domains added in the same plan are not counted.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "The ID of the web hosting domain.",
@@ -146,12 +409,12 @@ func (r *webHostingResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 			"ip_address": schema.StringAttribute{
-				Description: "The IP address for the domain.",
+				Description: "The IPv4 address for the domain, or '*' for all addresses. Must be configured on the server (see ispconfig_server_ip) with virtualhost enabled; a warning is shown during plan otherwise.",
 				Optional:    true,
 				Computed:    true,
 			},
 			"ipv6_address": schema.StringAttribute{
-				Description: "The IPv6 address for the domain.",
+				Description: "The IPv6 address for the domain. Must be configured on the server (see ispconfig_server_ip) with virtualhost enabled; a warning is shown during plan otherwise.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -248,6 +511,47 @@ func (r *webHostingResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"ssl_letsencrypt": schema.BoolAttribute{
+				Description: "Request a Let's Encrypt certificate for the domain. Requires ssl = true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"wait_for_ssl_certificate": schema.BoolAttribute{
+				Description: "Wait until ISPConfig reports the Let's Encrypt certificate before finishing create or update, at most for the create or update timeout (see timeouts).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"ssl_cert_expiry": schema.StringAttribute{
+				Description: "Expiry of the certificate stored in ISPConfig (RFC 3339). Empty if there is no certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssl_cert_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of the certificate stored in ISPConfig. Empty if there is no certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssl_certificate": schema.StringAttribute{
+				Description: "PEM encoded certificate to install instead of Let's Encrypt. Requires ssl = true and ssl_private_key. Removing it deletes the certificate.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ssl_chain": schema.StringAttribute{
+				Description: "PEM encoded intermediate certificates (CA bundle) for ssl_certificate.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ssl_private_key": schema.StringAttribute{
+				Description: "PEM encoded private key of ssl_certificate. It is checked against the certificate before the API call.",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"subdomain": schema.StringAttribute{
 				Description: "Subdomain auto-redirect setting (e.g., 'www', 'none', '*'). Default 'www' creates www subdomain alias.",
 				Optional:    true,
@@ -287,6 +591,37 @@ func (r *webHostingResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 			},
+			"pm_max_children": schema.Int64Attribute{
+				Description: "PHP-FPM pm.max_children. Leave unset to use ISPConfig default.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"pm_start_servers": schema.Int64Attribute{
+				Description: "PHP-FPM pm.start_servers (pm = 'dynamic'). Must be between pm_min_spare_servers and pm_max_spare_servers.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"pm_min_spare_servers": schema.Int64Attribute{
+				Description: "PHP-FPM pm.min_spare_servers (pm = 'dynamic').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"pm_max_spare_servers": schema.Int64Attribute{
+				Description: "PHP-FPM pm.max_spare_servers (pm = 'dynamic'). Must not be greater than pm_max_children.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"php_fpm_chroot": schema.BoolAttribute{
+				Description: "Run the PHP-FPM pool chrooted to the web root.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"custom_php_ini": schema.MapAttribute{
+				Description: "Custom php.ini settings (e.g. { memory_limit = \"256M\" }), rendered into ISPConfig's custom php.ini field as 'key = value' lines.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"http_port": schema.Int64Attribute{
 				Description: "HTTP port number.",
 				Optional:    true,
@@ -305,10 +640,52 @@ func (r *webHostingResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 			"apache_directives": schema.StringAttribute{
-				Description: "Custom Apache directives to include in the vhost configuration.",
+				Description: "Custom Apache directives to include in the vhost configuration. Only valid on Apache servers.",
 				Optional:    true,
 				Computed:    true,
 			},
+			"nginx_directives": schema.StringAttribute{
+				Description: "Custom nginx directives to include in the vhost configuration. Only valid on nginx servers.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"proxy_directives": schema.StringAttribute{
+				Description: "Custom nginx proxy directives (used with redirect_type 'proxy'). Only valid on nginx servers.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"proxy_protocol": schema.BoolAttribute{
+				Description: "Accept the PROXY protocol on the vhost's listeners. Requires PROXY protocol to be enabled per website in the server's web configuration.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"stats_type": schema.StringAttribute{
+				Description: "Web statistics program: 'awstats', 'goaccess' or 'webalizer'. Defaults to the ISPConfig default.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"stats_password": schema.StringAttribute{
+				Description: "Password of the 'admin' user protecting the /stats page. ISPConfig only stores a hash, so the value is kept from the configuration and changes made outside Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"backup_interval": schema.StringAttribute{
+				Description: "Website backup schedule: 'none', 'daily', 'weekly' or 'monthly'. Only sent when set, so a schedule configured in the panel is kept otherwise.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_copies": schema.Int64Attribute{
+				Description: "Number of website backups to keep (1-30). Only sent when set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"disable_symlink_restriction": schema.BoolAttribute{
 				Description: "Deactivate symlinks restriction of the web space. When true, allows following symlinks regardless of owner.",
 				Optional:    true,
@@ -316,7 +693,86 @@ func (r *webHostingResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Default:     booldefault.StaticBool(false),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig checks the PHP-FPM pool invariants, custom php.ini,
+// statistics and backup settings at plan time. Unknown values are skipped.
+func (r *webHostingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config webHostingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool := fpmPoolSettings{PM: "ondemand"} // schema default
+	if !config.PM.IsNull() && !config.PM.IsUnknown() {
+		pool.PM = config.PM.ValueString()
+	}
+	for _, setting := range []struct {
+		name  string
+		value types.Int64
+		field *int64
+	}{
+		{"pm_max_children", config.PMMaxChildren, &pool.MaxChildren},
+		{"pm_start_servers", config.PMStartServers, &pool.StartServers},
+		{"pm_min_spare_servers", config.PMMinSpareServers, &pool.MinSpareServers},
+		{"pm_max_spare_servers", config.PMMaxSpareServers, &pool.MaxSpareServers},
+	} {
+		if setting.value.IsNull() || setting.value.IsUnknown() {
+			continue
+		}
+		if setting.value.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(setting.name),
+				"Invalid PHP-FPM Setting",
+				fmt.Sprintf("%s must be at least 1 (got %d).", setting.name, setting.value.ValueInt64()),
+			)
+			continue
+		}
+		*setting.field = setting.value.ValueInt64()
+	}
+	for _, problem := range pool.validate() {
+		resp.Diagnostics.AddError("Invalid PHP-FPM Pool Configuration", problem)
+	}
+
+	if !config.CustomPHPIni.IsNull() && !config.CustomPHPIni.IsUnknown() {
+		settings := map[string]string{}
+		for k, v := range config.CustomPHPIni.Elements() {
+			if s, ok := v.(types.String); ok && !s.IsUnknown() {
+				settings[k] = s.ValueString()
+			}
+		}
+		if err := validatePHPIniSettings(settings); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_php_ini"),
+				"Invalid Custom php.ini",
+				err.Error(),
+			)
+		}
+	}
+
+	if !config.StatsType.IsNull() && !config.StatsType.IsUnknown() {
+		if err := validateStatsType(config.StatsType.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("stats_type"),
+				"Invalid Statistics Type",
+				err.Error(),
+			)
+		}
 	}
+
+	resp.Diagnostics.Append(validateBackupConfig(config.BackupInterval, config.BackupCopies)...)
+}
+
+// customPHPIni renders the custom_php_ini map of the plan.
+func (m webHostingResourceModel) customPHPIni(ctx context.Context) (string, diag.Diagnostics) {
+	settings := map[string]string{}
+	diags := m.CustomPHPIni.ElementsAs(ctx, &settings, false)
+	return renderPHPIni(settings), diags
 }
 
 // Configure adds the provider configured client to the resource.
@@ -334,9 +790,194 @@ func (r *webHostingResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
+	r.phpVersions = providerData.PHPVersions
+}
+
+// ModifyPlan checks that the client belongs to the configured reseller,
+// limit_web_domain of the owning client when the resource is created, and
+// php_version and the IP addresses against those available on the target
+// server, so that invalid values fail the plan instead of the apply.
+func (r *webHostingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.clientScope.ModifyPlan(ctx, req, resp)
+	checkClientLimit(ctx, r.client, r.clientID, "limit_web_domain", req, resp)
+	resp.Diagnostics.Append(r.validatePHPVersion(ctx, req)...)
+	resp.Diagnostics.Append(r.validateIPAddresses(ctx, req)...)
+	resp.Diagnostics.Append(planSSLCertificateInfo(ctx, req, resp)...)
+}
+
+// planSSLCertificateInfo marks ssl_cert_expiry and ssl_cert_fingerprint as
+// unknown when an update may replace the certificate. Otherwise they keep
+// their state values (UseStateForUnknown).
+func planSSLCertificateInfo(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var plan, state webHostingResourceModel
+	diags.Append(req.Plan.Get(ctx, &plan)...)
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if plan.SSL.Equal(state.SSL) && plan.SSLLetsencrypt.Equal(state.SSLLetsencrypt) &&
+		plan.SSLCertificate.Equal(state.SSLCertificate) && plan.SSLChain.Equal(state.SSLChain) &&
+		plan.SSLPrivateKey.Equal(state.SSLPrivateKey) {
+		return diags
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("ssl_cert_expiry"), types.StringUnknown())...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("ssl_cert_fingerprint"), types.StringUnknown())...)
+	return diags
+}
+
+// configuredServerID returns the server a planned web hosting is created
+// on: server_id from the configuration, or the provider server_id when it is
+// not set. The plan value is unknown in that case, because server_id is
+// computed. known is false while the configured value is unknown.
+func (r *webHostingResource) configuredServerID(ctx context.Context, req resource.ModifyPlanRequest) (serverID int, known bool, diags diag.Diagnostics) {
+	var configServerID types.Int64
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("server_id"), &configServerID)...)
+	if diags.HasError() || configServerID.IsUnknown() {
+		return 0, false, diags
+	}
+	if configServerID.IsNull() {
+		return r.serverID, true, diags
+	}
+	return int(configServerID.ValueInt64()), true, diags
+}
+
+// validatePHPVersion checks a new or changed php_version. php and server_id
+// are read from the configuration with the same defaults as Create, since
+// both are computed and unknown in the plan when they are not set. Unknown
+// configuration values are skipped; they are checked again in Create/Update.
+func (r *webHostingResource) validatePHPVersion(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var phpVersion, php types.String
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("php_version"), &phpVersion)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("php"), &php)...)
+	targetServerID, serverKnown, serverDiags := r.configuredServerID(ctx, req)
+	diags.Append(serverDiags...)
+	if diags.HasError() || phpVersion.IsNull() || phpVersion.IsUnknown() || php.IsUnknown() || !serverKnown || targetServerID == 0 {
+		return diags
+	}
+	phpType := "php-fpm" // default handler type
+	if !php.IsNull() {
+		phpType = php.ValueString()
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state webHostingResourceModel
+		diags.Append(req.State.Get(ctx, &state)...)
+		if diags.HasError() || (phpVersion.Equal(state.PHPVersion) && phpType == state.PHP.ValueString() &&
+			int64(targetServerID) == state.ServerID.ValueInt64()) {
+			return diags
+		}
+	}
+
+	phpVersions, err := r.phpVersions.get(ctx, r.client, targetServerID, phpType)
+	if err != nil {
+		diags.AddError(
+			"Failed to Fetch PHP Versions",
+			fmt.Sprintf("Could not fetch available PHP versions from server: %s", err.Error()),
+		)
+		return diags
+	}
+	if _, err := phpVersionToFullString(phpVersions, phpVersion.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("php_version"), "Invalid PHP Version", err.Error())
+	}
+	return diags
+}
+
+// validateIPAddresses checks a new or changed ip_address and ipv6_address
+// against the IPs configured on the target server. A mismatch is only a
+// warning, because the address may be added by an ispconfig_server_ip in the
+// same apply, which the server IP table does not contain yet. If the server
+// IPs cannot be read, for example because the remote user lacks the server
+// functions, the check is skipped with a warning.
+func (r *webHostingResource) validateIPAddresses(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	var ipAddress, ipv6Address types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("ipv6_address"), &ipv6Address)...)
+	targetServerID, serverKnown, serverDiags := r.configuredServerID(ctx, req)
+	diags.Append(serverDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	addresses := []struct {
+		attribute string
+		ipType    string
+		value     types.String
+	}{
+		{"ip_address", "IPv4", ipAddress},
+		{"ipv6_address", "IPv6", ipv6Address},
+	}
+	if !req.State.Raw.IsNull() {
+		var stateServerID types.Int64
+		diags.Append(req.State.GetAttribute(ctx, path.Root("server_id"), &stateServerID)...)
+		for i := range addresses {
+			var stateValue types.String
+			diags.Append(req.State.GetAttribute(ctx, path.Root(addresses[i].attribute), &stateValue)...)
+			if addresses[i].value.Equal(stateValue) && int64(targetServerID) == stateServerID.ValueInt64() {
+				addresses[i].value = types.StringNull()
+			}
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	var ips []client.ServerIP
+	fetched := false
+	for _, address := range addresses {
+		value := address.value.ValueString()
+		if address.value.IsNull() || address.value.IsUnknown() || value == "" || value == "*" {
+			continue
+		}
+		if t, err := serverIPType(value); err != nil || t != address.ipType {
+			diags.AddAttributeError(
+				path.Root(address.attribute),
+				"Invalid IP Address",
+				fmt.Sprintf("%q is not a valid %s address.", value, address.ipType),
+			)
+			continue
+		}
+		// A server_id that is only known after apply may not be the
+		// provider default server.
+		if !serverKnown || targetServerID == 0 {
+			continue
+		}
+		if !fetched {
+			var err error
+			if ips, err = r.client.GetServerIPs(ctx, targetServerID); err != nil {
+				diags.AddWarning(
+					"Could not check IP addresses",
+					fmt.Sprintf("Could not read the IP addresses of server ID %d: %s", targetServerID, err.Error()),
+				)
+				return diags
+			}
+			fetched = true
+		}
+		if err := checkServerIP(ips, value, address.ipType); err != nil {
+			diags.AddAttributeWarning(
+				path.Root(address.attribute),
+				"IP Address Not Available",
+				err.Error()+". The apply fails unless the address is added to the server first, e.g. by an ispconfig_server_ip in the same configuration.",
+			)
+		}
+	}
+	return diags
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -348,6 +989,12 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate that document_root and root_subdir are not both set in config
 	if !plan.DocumentRoot.IsNull() && !plan.RootSubdir.IsNull() {
 		resp.Diagnostics.AddError(
@@ -357,17 +1004,8 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	resp.Diagnostics.Append(validateSSLConfiguration(plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -385,12 +1023,15 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Dynamically fetch PHP version mapping from the server if php_version is used
+	var phpVersions map[string]string
 	if !plan.PHPVersion.IsNull() {
 		phpType := "php-fpm" // default handler type
-		if !plan.PHP.IsNull() {
+		if !plan.PHP.IsNull() && !plan.PHP.IsUnknown() {
 			phpType = plan.PHP.ValueString()
 		}
-		if err := r.ensurePHPVersions(ctx, serverID, phpType); err != nil {
+		var err error
+		phpVersions, err = r.phpVersions.get(ctx, r.client, serverID, phpType)
+		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Fetch PHP Versions",
 				fmt.Sprintf("Could not fetch available PHP versions from server: %s", err.Error()),
@@ -399,6 +1040,16 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 	}
 
+	var config webHostingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.validateWebServerSettings(ctx, serverID, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build WebDomain struct
 	domain := &client.WebDomain{
 		Domain:   plan.Domain.ValueString(),
@@ -426,7 +1077,7 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 		domain.PHPVersion = plan.PHP.ValueString()
 	}
 	if !plan.PHPVersion.IsNull() {
-		fullStr, err := r.phpVersionToFullString(plan.PHPVersion.ValueString())
+		fullStr, err := phpVersionToFullString(phpVersions, plan.PHPVersion.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Invalid PHP Version",
@@ -468,6 +1119,15 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 	if !plan.SSL.IsNull() {
 		domain.SSL = boolToYN(plan.SSL.ValueBool())
 	}
+	if !plan.SSLLetsencrypt.IsNull() {
+		domain.SSLLetsencrypt = boolToYN(plan.SSLLetsencrypt.ValueBool())
+	}
+	if plan.hasCustomCertificate() {
+		domain.SSLCert = plan.SSLCertificate.ValueString()
+		domain.SSLBundle = plan.SSLChain.ValueString()
+		domain.SSLKey = plan.SSLPrivateKey.ValueString()
+		domain.SSLAction = "save"
+	}
 	if !plan.Subdomain.IsNull() {
 		domain.Subdomain = plan.Subdomain.ValueString()
 	}
@@ -501,6 +1161,41 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 	if !plan.ApacheDirectives.IsNull() {
 		domain.ApacheDirectives = plan.ApacheDirectives.ValueString()
 	}
+	if !plan.NginxDirectives.IsNull() {
+		domain.NginxDirectives = plan.NginxDirectives.ValueString()
+	}
+	if !plan.ProxyDirectives.IsNull() {
+		domain.ProxyDirectives = plan.ProxyDirectives.ValueString()
+	}
+	domain.ProxyProtocol = boolToYN(plan.ProxyProtocol.ValueBool())
+	if !plan.StatsType.IsNull() && !plan.StatsType.IsUnknown() {
+		domain.Stats = plan.StatsType.ValueString()
+	}
+	if !plan.StatsPassword.IsNull() {
+		domain.StatsPassword = plan.StatsPassword.ValueString()
+	}
+	domain.BackupInterval, domain.BackupCopies = backupSettings(plan.BackupInterval, plan.BackupCopies)
+	if !plan.PMMaxChildren.IsNull() && !plan.PMMaxChildren.IsUnknown() {
+		domain.PMMaxChildren = client.FlexInt(plan.PMMaxChildren.ValueInt64())
+	}
+	if !plan.PMStartServers.IsNull() && !plan.PMStartServers.IsUnknown() {
+		domain.PMStartServers = client.FlexInt(plan.PMStartServers.ValueInt64())
+	}
+	if !plan.PMMinSpareServers.IsNull() && !plan.PMMinSpareServers.IsUnknown() {
+		domain.PMMinSpareServers = client.FlexInt(plan.PMMinSpareServers.ValueInt64())
+	}
+	if !plan.PMMaxSpareServers.IsNull() && !plan.PMMaxSpareServers.IsUnknown() {
+		domain.PMMaxSpareServers = client.FlexInt(plan.PMMaxSpareServers.ValueInt64())
+	}
+	domain.PHPFPMChroot = boolToYN(plan.PHPFPMChroot.ValueBool())
+	if !plan.CustomPHPIni.IsNull() {
+		ini, diags := plan.customPHPIni(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		domain.CustomPHPIni = &ini
+	}
 	// Always send disable_symlink_restriction (defaults to false/"n")
 	domain.DisableSymlinkNotOwner = boolToYN(plan.DisableSymlinkNotOwner.ValueBool())
 
@@ -608,18 +1303,64 @@ func (r *webHostingResource) Create(ctx context.Context, req resource.CreateRequ
 	if plan.PMMaxRequests.IsNull() || plan.PMMaxRequests.IsUnknown() {
 		plan.PMMaxRequests = types.Int64Value(int64(createdDomain.PMMaxRequests))
 	}
+	if plan.PMMaxChildren.IsNull() || plan.PMMaxChildren.IsUnknown() {
+		plan.PMMaxChildren = types.Int64Value(int64(createdDomain.PMMaxChildren))
+	}
+	if plan.PMStartServers.IsNull() || plan.PMStartServers.IsUnknown() {
+		plan.PMStartServers = types.Int64Value(int64(createdDomain.PMStartServers))
+	}
+	if plan.PMMinSpareServers.IsNull() || plan.PMMinSpareServers.IsUnknown() {
+		plan.PMMinSpareServers = types.Int64Value(int64(createdDomain.PMMinSpareServers))
+	}
+	if plan.PMMaxSpareServers.IsNull() || plan.PMMaxSpareServers.IsUnknown() {
+		plan.PMMaxSpareServers = types.Int64Value(int64(createdDomain.PMMaxSpareServers))
+	}
 	if plan.PHPOpenBasedir.IsNull() || plan.PHPOpenBasedir.IsUnknown() {
 		plan.PHPOpenBasedir = types.StringValue(createdDomain.PHPOpenBasedir)
 	}
 	if plan.ApacheDirectives.IsNull() || plan.ApacheDirectives.IsUnknown() {
 		plan.ApacheDirectives = types.StringValue(createdDomain.ApacheDirectives)
 	}
+	if plan.NginxDirectives.IsNull() || plan.NginxDirectives.IsUnknown() {
+		plan.NginxDirectives = types.StringValue(createdDomain.NginxDirectives)
+	}
+	if plan.ProxyDirectives.IsNull() || plan.ProxyDirectives.IsUnknown() {
+		plan.ProxyDirectives = types.StringValue(createdDomain.ProxyDirectives)
+	}
+	if plan.StatsType.IsNull() || plan.StatsType.IsUnknown() {
+		plan.StatsType = types.StringValue(createdDomain.Stats)
+	}
+	if plan.BackupInterval.IsNull() || plan.BackupInterval.IsUnknown() {
+		plan.BackupInterval = types.StringValue(createdDomain.BackupInterval)
+	}
+	if plan.BackupCopies.IsNull() || plan.BackupCopies.IsUnknown() {
+		plan.BackupCopies = types.Int64Value(int64(createdDomain.BackupCopies))
+	}
 	if plan.DisableSymlinkNotOwner.IsNull() || plan.DisableSymlinkNotOwner.IsUnknown() {
 		plan.DisableSymlinkNotOwner = types.BoolValue(ynToBool(createdDomain.DisableSymlinkNotOwner))
 	}
+	setSSLCertificateInfo(ctx, &plan, createdDomain.SSLCert)
+
+	// The computed values are filled first, so that a failed wait can save a
+	// complete state.
+	if plan.SSLLetsencrypt.ValueBool() && plan.WaitForSSLCertificate.ValueBool() {
+		issuedDomain, err := waitForSSLCertificate(ctx, r.client, domainID)
+		if err != nil {
+			// The domain exists, so keep it in state; Terraform marks it tainted.
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				"Error waiting for SSL certificate",
+				err.Error(),
+			)
+			return
+		}
+		setSSLCertificateInfo(ctx, &plan, issuedDomain.SSLCert)
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -679,6 +1420,11 @@ func (r *webHostingResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.Python = types.BoolValue(ynToBool(domain.Python))
 	state.SuExec = types.BoolValue(ynToBool(domain.SuExec))
 	state.SSL = types.BoolValue(ynToBool(domain.SSL))
+	state.SSLLetsencrypt = types.BoolValue(ynToBool(domain.SSLLetsencrypt))
+	setSSLCertificateInfo(ctx, &state, domain.SSLCert)
+	// Note: ssl_certificate, ssl_chain and ssl_private_key are kept from state.
+	// ISPConfig normalizes the stored PEM data, and Let's Encrypt renewals
+	// replace it; ssl_cert_fingerprint reflects the installed certificate.
 	state.Subdomain = types.StringValue(domain.Subdomain)
 	state.RedirectType = types.StringValue(domain.RedirectType)
 	state.RedirectPath = types.StringValue(domain.RedirectPath)
@@ -688,6 +1434,29 @@ func (r *webHostingResource) Read(ctx context.Context, req resource.ReadRequest,
 	if domain.PMMaxRequests != 0 {
 		state.PMMaxRequests = types.Int64Value(int64(domain.PMMaxRequests))
 	}
+	if domain.PMMaxChildren != 0 {
+		state.PMMaxChildren = types.Int64Value(int64(domain.PMMaxChildren))
+	}
+	if domain.PMStartServers != 0 {
+		state.PMStartServers = types.Int64Value(int64(domain.PMStartServers))
+	}
+	if domain.PMMinSpareServers != 0 {
+		state.PMMinSpareServers = types.Int64Value(int64(domain.PMMinSpareServers))
+	}
+	if domain.PMMaxSpareServers != 0 {
+		state.PMMaxSpareServers = types.Int64Value(int64(domain.PMMaxSpareServers))
+	}
+	state.PHPFPMChroot = types.BoolValue(ynToBool(domain.PHPFPMChroot))
+	// custom_php_ini stays null unless it is configured or set in ISPConfig
+	iniSettings := map[string]string{}
+	if domain.CustomPHPIni != nil {
+		iniSettings = parsePHPIni(*domain.CustomPHPIni)
+	}
+	if len(iniSettings) > 0 || !state.CustomPHPIni.IsNull() {
+		iniMap, diags := types.MapValueFrom(ctx, types.StringType, iniSettings)
+		resp.Diagnostics.Append(diags...)
+		state.CustomPHPIni = iniMap
+	}
 	if domain.HTTPPort != 0 {
 		state.HTTPPort = types.Int64Value(int64(domain.HTTPPort))
 	}
@@ -696,6 +1465,19 @@ func (r *webHostingResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	state.PHPOpenBasedir = types.StringValue(domain.PHPOpenBasedir)
 	state.ApacheDirectives = types.StringValue(domain.ApacheDirectives)
+	state.NginxDirectives = types.StringValue(domain.NginxDirectives)
+	state.ProxyDirectives = types.StringValue(domain.ProxyDirectives)
+	state.ProxyProtocol = types.BoolValue(ynToBool(domain.ProxyProtocol))
+	if domain.Stats != "" {
+		state.StatsType = types.StringValue(domain.Stats)
+	}
+	// stats_password is kept from state: the API only returns the hash
+	if domain.BackupInterval != "" {
+		state.BackupInterval = types.StringValue(domain.BackupInterval)
+	}
+	if domain.BackupCopies != 0 {
+		state.BackupCopies = types.Int64Value(int64(domain.BackupCopies))
+	}
 	state.DisableSymlinkNotOwner = types.BoolValue(ynToBool(domain.DisableSymlinkNotOwner))
 
 	diags = resp.State.Set(ctx, &state)
@@ -711,6 +1493,12 @@ func (r *webHostingResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate that document_root and root_subdir are not both explicitly set in config
 	// We check the config directly to avoid false positives from computed state values
 	var config webHostingResourceModel
@@ -728,22 +1516,13 @@ func (r *webHostingResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	domainID := int(plan.ID.ValueInt64())
-
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	resp.Diagnostics.Append(validateSSLConfiguration(plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	domainID := int(plan.ID.ValueInt64())
+
 	// Get current state to check if root_subdir is being added/changed/removed
 	var currentState webHostingResourceModel
 	diags = req.State.Get(ctx, &currentState)
@@ -833,20 +1612,25 @@ func (r *webHostingResource) Update(ctx context.Context, req resource.UpdateRequ
 		)
 		return
 	}
+	resp.Diagnostics.Append(r.validateWebServerSettings(ctx, serverID, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Dynamically fetch PHP version mapping from the server if php_version is used
 	if !plan.PHPVersion.IsNull() {
 		phpType := "php-fpm" // default handler type
-		if !plan.PHP.IsNull() {
+		if !plan.PHP.IsNull() && !plan.PHP.IsUnknown() {
 			phpType = plan.PHP.ValueString()
 		}
-		if err := r.ensurePHPVersions(ctx, serverID, phpType); err != nil {
+		phpVersions, err := r.phpVersions.get(ctx, r.client, serverID, phpType)
+		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Fetch PHP Versions",
 				fmt.Sprintf("Could not fetch available PHP versions from server: %s", err.Error()),
 			)
 			return
 		}
-		fullStr, err := r.phpVersionToFullString(plan.PHPVersion.ValueString())
+		fullStr, err := phpVersionToFullString(phpVersions, plan.PHPVersion.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Invalid PHP Version",
@@ -888,6 +1672,10 @@ func (r *webHostingResource) Update(ctx context.Context, req resource.UpdateRequ
 	if !plan.SSL.IsNull() {
 		domain.SSL = boolToYN(plan.SSL.ValueBool())
 	}
+	if !plan.SSLLetsencrypt.IsNull() {
+		domain.SSLLetsencrypt = boolToYN(plan.SSLLetsencrypt.ValueBool())
+	}
+	setSSLCertificateAction(domain, plan, currentState)
 	if !plan.Subdomain.IsNull() {
 		domain.Subdomain = plan.Subdomain.ValueString()
 	}
@@ -921,6 +1709,47 @@ func (r *webHostingResource) Update(ctx context.Context, req resource.UpdateRequ
 	if !plan.ApacheDirectives.IsNull() {
 		domain.ApacheDirectives = plan.ApacheDirectives.ValueString()
 	}
+	if !plan.NginxDirectives.IsNull() {
+		domain.NginxDirectives = plan.NginxDirectives.ValueString()
+	}
+	if !plan.ProxyDirectives.IsNull() {
+		domain.ProxyDirectives = plan.ProxyDirectives.ValueString()
+	}
+	domain.ProxyProtocol = boolToYN(plan.ProxyProtocol.ValueBool())
+	if !plan.StatsType.IsNull() && !plan.StatsType.IsUnknown() {
+		domain.Stats = plan.StatsType.ValueString()
+	}
+	if !plan.StatsPassword.IsNull() {
+		domain.StatsPassword = plan.StatsPassword.ValueString()
+	}
+	// Only send the backup settings set in the configuration; the plan holds
+	// the state value otherwise.
+	domain.BackupInterval, domain.BackupCopies = backupSettings(config.BackupInterval, config.BackupCopies)
+	if !plan.PMMaxChildren.IsNull() && !plan.PMMaxChildren.IsUnknown() {
+		domain.PMMaxChildren = client.FlexInt(plan.PMMaxChildren.ValueInt64())
+	}
+	if !plan.PMStartServers.IsNull() && !plan.PMStartServers.IsUnknown() {
+		domain.PMStartServers = client.FlexInt(plan.PMStartServers.ValueInt64())
+	}
+	if !plan.PMMinSpareServers.IsNull() && !plan.PMMinSpareServers.IsUnknown() {
+		domain.PMMinSpareServers = client.FlexInt(plan.PMMinSpareServers.ValueInt64())
+	}
+	if !plan.PMMaxSpareServers.IsNull() && !plan.PMMaxSpareServers.IsUnknown() {
+		domain.PMMaxSpareServers = client.FlexInt(plan.PMMaxSpareServers.ValueInt64())
+	}
+	domain.PHPFPMChroot = boolToYN(plan.PHPFPMChroot.ValueBool())
+	if !plan.CustomPHPIni.IsNull() {
+		ini, diags := plan.customPHPIni(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		domain.CustomPHPIni = &ini
+	} else if !currentState.CustomPHPIni.IsNull() {
+		// custom_php_ini was removed from the configuration - clear it
+		empty := ""
+		domain.CustomPHPIni = &empty
+	}
 	// Always send disable_symlink_restriction (defaults to false/"n")
 	domain.DisableSymlinkNotOwner = boolToYN(plan.DisableSymlinkNotOwner.ValueBool())
 
@@ -946,6 +1775,17 @@ func (r *webHostingResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if plan.SSLLetsencrypt.ValueBool() && plan.WaitForSSLCertificate.ValueBool() && updatedDomain.SSLCert == "" {
+		updatedDomain, err = waitForSSLCertificate(ctx, r.client, domainID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for SSL certificate",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	// Update plan with any computed values - always set when Unknown or Null
 	if plan.IPAddress.IsNull() || plan.IPAddress.IsUnknown() {
 		plan.IPAddress = types.StringValue(updatedDomain.IPAddress)
@@ -988,18 +1828,50 @@ func (r *webHostingResource) Update(ctx context.Context, req resource.UpdateRequ
 	if plan.PMMaxRequests.IsNull() || plan.PMMaxRequests.IsUnknown() {
 		plan.PMMaxRequests = types.Int64Value(int64(updatedDomain.PMMaxRequests))
 	}
+	if plan.PMMaxChildren.IsNull() || plan.PMMaxChildren.IsUnknown() {
+		plan.PMMaxChildren = types.Int64Value(int64(updatedDomain.PMMaxChildren))
+	}
+	if plan.PMStartServers.IsNull() || plan.PMStartServers.IsUnknown() {
+		plan.PMStartServers = types.Int64Value(int64(updatedDomain.PMStartServers))
+	}
+	if plan.PMMinSpareServers.IsNull() || plan.PMMinSpareServers.IsUnknown() {
+		plan.PMMinSpareServers = types.Int64Value(int64(updatedDomain.PMMinSpareServers))
+	}
+	if plan.PMMaxSpareServers.IsNull() || plan.PMMaxSpareServers.IsUnknown() {
+		plan.PMMaxSpareServers = types.Int64Value(int64(updatedDomain.PMMaxSpareServers))
+	}
 	if plan.PHPOpenBasedir.IsNull() || plan.PHPOpenBasedir.IsUnknown() {
 		plan.PHPOpenBasedir = types.StringValue(updatedDomain.PHPOpenBasedir)
 	}
 	if plan.ApacheDirectives.IsNull() || plan.ApacheDirectives.IsUnknown() {
 		plan.ApacheDirectives = types.StringValue(updatedDomain.ApacheDirectives)
 	}
+	if plan.NginxDirectives.IsNull() || plan.NginxDirectives.IsUnknown() {
+		plan.NginxDirectives = types.StringValue(updatedDomain.NginxDirectives)
+	}
+	if plan.ProxyDirectives.IsNull() || plan.ProxyDirectives.IsUnknown() {
+		plan.ProxyDirectives = types.StringValue(updatedDomain.ProxyDirectives)
+	}
+	if plan.StatsType.IsNull() || plan.StatsType.IsUnknown() {
+		plan.StatsType = types.StringValue(updatedDomain.Stats)
+	}
+	if plan.BackupInterval.IsNull() || plan.BackupInterval.IsUnknown() {
+		plan.BackupInterval = types.StringValue(updatedDomain.BackupInterval)
+	}
+	if plan.BackupCopies.IsNull() || plan.BackupCopies.IsUnknown() {
+		plan.BackupCopies = types.Int64Value(int64(updatedDomain.BackupCopies))
+	}
 	if plan.DisableSymlinkNotOwner.IsNull() || plan.DisableSymlinkNotOwner.IsUnknown() {
 		plan.DisableSymlinkNotOwner = types.BoolValue(ynToBool(updatedDomain.DisableSymlinkNotOwner))
 	}
+	if plan.SSLCertExpiry.IsUnknown() || plan.SSLCertFingerprint.IsUnknown() {
+		setSSLCertificateInfo(ctx, &plan, updatedDomain.SSLCert)
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -1011,6 +1883,12 @@ func (r *webHostingResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	domainID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteWebDomain(ctx, domainID)
@@ -1023,6 +1901,10 @@ func (r *webHostingResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	tflog.Trace(ctx, "Deleted web hosting", map[string]interface{}{"id": domainID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
 // ImportState imports the resource state.
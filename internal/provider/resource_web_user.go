@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,6 +25,7 @@ var (
 	_ resource.ResourceWithConfigure    = &webUserResource{}
 	_ resource.ResourceWithImportState  = &webUserResource{}
 	_ resource.ResourceWithUpgradeState = &webUserResource{}
+	_ resource.ResourceWithModifyPlan   = &webUserResource{}
 )
 
 // NewWebUserResource is a helper function to simplify the provider implementation.
@@ -33,9 +35,7 @@ func NewWebUserResource() resource.Resource {
 
 // webUserResource is the resource implementation.
 type webUserResource struct {
-	client   *client.Client
-	clientID int
-	serverID int
+	clientScope
 }
 
 // webUserResourceModel maps the resource schema data.
@@ -52,6 +52,8 @@ type webUserResourceModel struct {
 	ServerID       types.Int64  `tfsdk:"server_id"`
 	UID            types.String `tfsdk:"uid"`
 	GID            types.String `tfsdk:"gid"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // webUserResourceModelV0 represents the old state model with string active attribute
@@ -76,7 +78,7 @@ func (r *webUserResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 // Schema defines the schema for the resource.
-func (r *webUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *webUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version:     1,
 		Description: "Manages a shell user in ISP Config.",
@@ -141,6 +143,9 @@ func (r *webUserResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -159,9 +164,7 @@ func (r *webUserResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	r.client = providerData.Client
-	r.clientID = providerData.ClientID
-	r.serverID = providerData.ServerID
+	r.configure(providerData)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -173,17 +176,9 @@ func (r *webUserResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -283,6 +278,8 @@ func (r *webUserResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -336,22 +333,14 @@ func (r *webUserResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	userID := int(plan.ID.ValueInt64())
-
-	// Determine client ID
-	clientID := r.clientID
-	if !plan.ClientID.IsNull() {
-		clientID = int(plan.ClientID.ValueInt64())
-	}
-
-	if clientID == 0 {
-		resp.Diagnostics.AddError(
-			"Missing Client ID",
-			"Client ID must be set either in the provider configuration or in the resource configuration.",
-		)
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	userID := int(plan.ID.ValueInt64())
+
 	// Fetch parent domain to get system user/group
 	parentDomain, err := r.client.GetWebDomain(ctx, int(plan.ParentDomainID.ValueInt64()))
 	if err != nil {
@@ -446,6 +435,8 @@ func (r *webUserResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -457,6 +448,12 @@ func (r *webUserResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	userID := int(state.ID.ValueInt64())
 
 	err := r.client.DeleteShellUser(ctx, userID)
@@ -469,6 +466,10 @@ func (r *webUserResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	tflog.Trace(ctx, "Deleted shell user", map[string]interface{}{"id": userID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
 }
 
 // ImportState imports the resource state.
@@ -579,6 +580,7 @@ func (r *webUserResource) UpgradeState(ctx context.Context) map[int64]resource.S
 				newState.ServerID = oldState.ServerID
 				newState.UID = oldState.UID
 				newState.GID = oldState.GID
+				newState.Timeouts = nullTimeouts()
 
 				// Set the upgraded state
 				resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
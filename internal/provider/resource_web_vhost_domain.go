@@ -0,0 +1,610 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// ISPConfig web domain types for vhosts that live under a parent site.
+const (
+	vhostTypeSubdomain = "vhostsubdomain"
+	vhostTypeAlias     = "vhostalias"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webVhostDomainResource{}
+	_ resource.ResourceWithConfigure   = &webVhostDomainResource{}
+	_ resource.ResourceWithImportState = &webVhostDomainResource{}
+	_ resource.ResourceWithModifyPlan  = &webVhostDomainResource{}
+)
+
+// NewWebVhostSubdomainResource is a helper function to simplify the provider implementation.
+func NewWebVhostSubdomainResource() resource.Resource {
+	return &webVhostDomainResource{
+		vhostType: vhostTypeSubdomain,
+		typeName:  "_web_vhost_subdomain",
+		label:     "vhost subdomain",
+	}
+}
+
+// NewWebVhostAliasResource is a helper function to simplify the provider implementation.
+func NewWebVhostAliasResource() resource.Resource {
+	return &webVhostDomainResource{
+		vhostType: vhostTypeAlias,
+		typeName:  "_web_vhost_alias",
+		label:     "vhost alias domain",
+	}
+}
+
+// webVhostDomainResource is the resource implementation shared by
+// ispconfig_web_vhost_subdomain and ispconfig_web_vhost_alias. Both are
+// separate vhosts with their own document root and PHP settings below a
+// parent web domain; they only differ in the ISPConfig type and API methods.
+type webVhostDomainResource struct {
+	clientScope
+	phpVersions *phpVersionCache
+
+	vhostType string
+	typeName  string
+	label     string
+}
+
+// webVhostDomainResourceModel maps the resource schema data.
+type webVhostDomainResourceModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	ClientID             types.Int64  `tfsdk:"client_id"`
+	ParentDomainID       types.Int64  `tfsdk:"parent_domain_id"`
+	Domain               types.String `tfsdk:"domain"`
+	WebFolder            types.String `tfsdk:"web_folder"`
+	DocumentRoot         types.String `tfsdk:"document_root"`
+	IPAddress            types.String `tfsdk:"ip_address"`
+	IPv6Address          types.String `tfsdk:"ipv6_address"`
+	PHP                  types.String `tfsdk:"php"`
+	PHPVersion           types.String `tfsdk:"php_version"`
+	Active               types.Bool   `tfsdk:"active"`
+	ServerID             types.Int64  `tfsdk:"server_id"`
+	SSL                  types.Bool   `tfsdk:"ssl"`
+	Subdomain            types.String `tfsdk:"subdomain"`
+	RedirectType         types.String `tfsdk:"redirect_type"`
+	RedirectPath         types.String `tfsdk:"redirect_path"`
+	PM                   types.String `tfsdk:"pm"`
+	PMProcessIdleTimeout types.String `tfsdk:"pm_process_idle_timeout"`
+	PMMaxRequests        types.Int64  `tfsdk:"pm_max_requests"`
+	PHPOpenBasedir       types.String `tfsdk:"php_open_basedir"`
+	ApacheDirectives     types.String `tfsdk:"apache_directives"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *webVhostDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *webVhostDomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Manages a vhost subdomain in ISP Config. A vhost subdomain is a separate vhost below a parent web hosting domain, " +
+		"with its own document root and PHP settings (e.g. a staging subdomain running a different PHP version than production)."
+	domainDescription := "The fully qualified subdomain name (e.g. 'staging.example.com')."
+	if r.vhostType == vhostTypeAlias {
+		description = "Manages a vhost alias domain in ISP Config. A vhost alias domain is a separate vhost for another domain name below a parent web hosting domain, " +
+			"with its own document root and PHP settings."
+		domainDescription = "The alias domain name (e.g. 'example.net')."
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the " + r.label + ".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.Int64Attribute{
+				Description: "The ISP Config client ID.",
+				Optional:    true,
+			},
+			"parent_domain_id": schema.Int64Attribute{
+				Description: "The ID of the parent web hosting domain. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: domainDescription,
+				Required:    true,
+			},
+			"web_folder": schema.StringAttribute{
+				Description: "The folder below the parent domain's web root that serves as document root (e.g. 'staging'). Defaults to the value chosen by ISPConfig.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"document_root": schema.StringAttribute{
+				Description: "The document root of the parent domain as reported by ISPConfig.",
+				Computed:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "The IP address for the vhost. Defaults to the IP address of the parent domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ipv6_address": schema.StringAttribute{
+				Description: "The IPv6 address for the vhost.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"php": schema.StringAttribute{
+				Description: "PHP mode (e.g., 'php-fpm', 'fast-cgi', 'mod', 'no').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"php_version": schema.StringAttribute{
+				Description: "PHP version (e.g. 8.4). Available versions are fetched dynamically from the server and may differ from the parent domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the vhost is active.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "The server ID. Defaults to the server of the parent domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ssl": schema.BoolAttribute{
+				Description: "Enable SSL.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"subdomain": schema.StringAttribute{
+				Description: "Subdomain auto-redirect setting (e.g., 'www', 'none', '*').",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("none"),
+			},
+			"redirect_type": schema.StringAttribute{
+				Description: "The redirect type (e.g., '', 'R', 'L', 'R=301', 'R=302').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"redirect_path": schema.StringAttribute{
+				Description: "The redirect path.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"pm": schema.StringAttribute{
+				Description: "PHP-FPM process manager type: 'dynamic', 'static', 'ondemand'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ondemand"),
+			},
+			"pm_process_idle_timeout": schema.StringAttribute{
+				Description: "PHP-FPM process idle timeout in seconds.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("10"),
+			},
+			"pm_max_requests": schema.Int64Attribute{
+				Description: "PHP-FPM max requests per process. Leave unset to use ISPConfig default.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"php_open_basedir": schema.StringAttribute{
+				Description: "PHP open_basedir restriction. Limits which directories PHP can access.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"apache_directives": schema.StringAttribute{
+				Description: "Custom Apache directives to include in the vhost configuration.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webVhostDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.configure(providerData)
+	r.phpVersions = providerData.PHPVersions
+}
+
+// addVhost, getVhost, updateVhost and deleteVhost dispatch to the ISPConfig
+// API methods matching the vhost type of this resource.
+func (r *webVhostDomainResource) addVhost(ctx context.Context, domain *client.WebDomain, clientID int) (int, error) {
+	if r.vhostType == vhostTypeAlias {
+		return r.client.AddWebVhostAliasDomain(ctx, domain, clientID)
+	}
+	return r.client.AddWebVhostSubdomain(ctx, domain, clientID)
+}
+
+func (r *webVhostDomainResource) getVhost(ctx context.Context, domainID int) (*client.WebDomain, error) {
+	if r.vhostType == vhostTypeAlias {
+		return r.client.GetWebVhostAliasDomain(ctx, domainID)
+	}
+	return r.client.GetWebVhostSubdomain(ctx, domainID)
+}
+
+func (r *webVhostDomainResource) updateVhost(ctx context.Context, domainID int, clientID int, domain *client.WebDomain) error {
+	if r.vhostType == vhostTypeAlias {
+		return r.client.UpdateWebVhostAliasDomain(ctx, domainID, clientID, domain)
+	}
+	return r.client.UpdateWebVhostSubdomain(ctx, domainID, clientID, domain)
+}
+
+func (r *webVhostDomainResource) deleteVhost(ctx context.Context, domainID int) error {
+	if r.vhostType == vhostTypeAlias {
+		return r.client.DeleteWebVhostAliasDomain(ctx, domainID)
+	}
+	return r.client.DeleteWebVhostSubdomain(ctx, domainID)
+}
+
+// buildWebDomain converts the plan into the WebDomain payload sent to
+// ISPConfig. Server and IP address are inherited from the parent domain when
+// not set, and php_version is resolved against the PHP versions installed on
+// the target server.
+func (r *webVhostDomainResource) buildWebDomain(ctx context.Context, plan *webVhostDomainResourceModel, clientID int) (*client.WebDomain, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parentDomain, err := r.client.GetWebDomain(ctx, int(plan.ParentDomainID.ValueInt64()))
+	if err != nil {
+		diags.AddError(
+			"Error fetching parent domain",
+			"Could not fetch parent domain to inherit server settings: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	// ServerID: resource value, then parent domain, then provider default
+	serverID := r.serverID
+	if !plan.ServerID.IsNull() && !plan.ServerID.IsUnknown() {
+		serverID = int(plan.ServerID.ValueInt64())
+	} else if parentDomain.ServerID != 0 {
+		serverID = int(parentDomain.ServerID)
+	}
+	if serverID == 0 {
+		diags.AddError(
+			"Missing Server ID",
+			"Server ID must be set either in the provider configuration or in the resource configuration, or be inherited from the parent domain.",
+		)
+		return nil, diags
+	}
+	plan.ServerID = types.Int64Value(int64(serverID))
+
+	domain := &client.WebDomain{
+		Domain:         plan.Domain.ValueString(),
+		Type:           r.vhostType,
+		ParentDomainID: client.FlexInt(plan.ParentDomainID.ValueInt64()),
+		ClientID:       client.FlexInt(clientID),
+		ServerID:       client.FlexInt(serverID),
+	}
+
+	if !plan.WebFolder.IsNull() && !plan.WebFolder.IsUnknown() {
+		domain.WebFolder = plan.WebFolder.ValueString()
+	}
+	if !plan.IPAddress.IsNull() && !plan.IPAddress.IsUnknown() {
+		domain.IPAddress = plan.IPAddress.ValueString()
+	} else {
+		domain.IPAddress = parentDomain.IPAddress
+	}
+	if !plan.IPv6Address.IsNull() && !plan.IPv6Address.IsUnknown() {
+		domain.IPv6Address = plan.IPv6Address.ValueString()
+	}
+	if !plan.PHP.IsNull() && !plan.PHP.IsUnknown() {
+		domain.PHPVersion = plan.PHP.ValueString()
+	}
+	if !plan.PHPVersion.IsNull() && !plan.PHPVersion.IsUnknown() {
+		phpType := "php-fpm" // default handler type
+		if domain.PHPVersion != "" {
+			phpType = domain.PHPVersion
+		}
+		phpVersions, err := r.phpVersions.get(ctx, r.client, serverID, phpType)
+		if err != nil {
+			diags.AddError(
+				"Failed to Fetch PHP Versions",
+				fmt.Sprintf("Could not fetch available PHP versions from server: %s", err.Error()),
+			)
+			return nil, diags
+		}
+		fullStr, err := phpVersionToFullString(phpVersions, plan.PHPVersion.ValueString())
+		if err != nil {
+			diags.AddError("Invalid PHP Version", err.Error())
+			return nil, diags
+		}
+		domain.FastcgiPHPVersion = fullStr
+	}
+	if !plan.Active.IsNull() {
+		domain.Active = boolToYN(plan.Active.ValueBool())
+	}
+	if !plan.SSL.IsNull() {
+		domain.SSL = boolToYN(plan.SSL.ValueBool())
+	}
+	if !plan.Subdomain.IsNull() {
+		domain.Subdomain = plan.Subdomain.ValueString()
+	}
+	if !plan.RedirectType.IsNull() && !plan.RedirectType.IsUnknown() {
+		domain.RedirectType = plan.RedirectType.ValueString()
+	}
+	if !plan.RedirectPath.IsNull() && !plan.RedirectPath.IsUnknown() {
+		domain.RedirectPath = plan.RedirectPath.ValueString()
+	}
+	if !plan.PM.IsNull() {
+		domain.PM = plan.PM.ValueString()
+	}
+	if !plan.PMProcessIdleTimeout.IsNull() {
+		domain.PMProcess = plan.PMProcessIdleTimeout.ValueString()
+	}
+	if !plan.PMMaxRequests.IsNull() && !plan.PMMaxRequests.IsUnknown() {
+		domain.PMMaxRequests = client.FlexInt(plan.PMMaxRequests.ValueInt64())
+	}
+	if !plan.PHPOpenBasedir.IsNull() && !plan.PHPOpenBasedir.IsUnknown() {
+		domain.PHPOpenBasedir = plan.PHPOpenBasedir.ValueString()
+	}
+	if !plan.ApacheDirectives.IsNull() && !plan.ApacheDirectives.IsUnknown() {
+		domain.ApacheDirectives = plan.ApacheDirectives.ValueString()
+	}
+
+	return domain, diags
+}
+
+// setComputedValues fills attributes that are Unknown or Null in the plan with
+// the values reported by ISPConfig after a write.
+func (r *webVhostDomainResource) setComputedValues(plan *webVhostDomainResourceModel, domain *client.WebDomain) {
+	if plan.WebFolder.IsNull() || plan.WebFolder.IsUnknown() {
+		plan.WebFolder = types.StringValue(domain.WebFolder)
+	}
+	plan.DocumentRoot = types.StringValue(domain.DocumentRoot)
+	if plan.IPAddress.IsNull() || plan.IPAddress.IsUnknown() {
+		plan.IPAddress = types.StringValue(domain.IPAddress)
+	}
+	if plan.IPv6Address.IsNull() || plan.IPv6Address.IsUnknown() {
+		plan.IPv6Address = types.StringValue(domain.IPv6Address)
+	}
+	if plan.PHP.IsNull() || plan.PHP.IsUnknown() {
+		plan.PHP = types.StringValue(domain.PHPVersion)
+	}
+	if plan.PHPVersion.IsNull() || plan.PHPVersion.IsUnknown() {
+		plan.PHPVersion = types.StringValue(client.ParsePHPVersion(domain.FastcgiPHPVersion))
+	}
+	if plan.RedirectType.IsNull() || plan.RedirectType.IsUnknown() {
+		plan.RedirectType = types.StringValue(domain.RedirectType)
+	}
+	if plan.RedirectPath.IsNull() || plan.RedirectPath.IsUnknown() {
+		plan.RedirectPath = types.StringValue(domain.RedirectPath)
+	}
+	if plan.PMMaxRequests.IsNull() || plan.PMMaxRequests.IsUnknown() {
+		plan.PMMaxRequests = types.Int64Value(int64(domain.PMMaxRequests))
+	}
+	if plan.PHPOpenBasedir.IsNull() || plan.PHPOpenBasedir.IsUnknown() {
+		plan.PHPOpenBasedir = types.StringValue(domain.PHPOpenBasedir)
+	}
+	if plan.ApacheDirectives.IsNull() || plan.ApacheDirectives.IsUnknown() {
+		plan.ApacheDirectives = types.StringValue(domain.ApacheDirectives)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webVhostDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webVhostDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, diags := r.buildWebDomain(ctx, &plan, clientID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID, err := r.addVhost(ctx, domain, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating "+r.label,
+			fmt.Sprintf("Could not create %s, unexpected error: %s", r.label, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created "+r.label, map[string]interface{}{"id": domainID})
+	plan.ID = types.Int64Value(int64(domainID))
+
+	createdDomain, err := r.getVhost(ctx, domainID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading created "+r.label,
+			fmt.Sprintf("Could not read created %s, unexpected error: %s", r.label, err.Error()),
+		)
+		return
+	}
+
+	r.setComputedValues(&plan, createdDomain)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webVhostDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webVhostDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := int(state.ID.ValueInt64())
+
+	domain, err := r.getVhost(ctx, domainID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+r.label,
+			fmt.Sprintf("Could not read %s ID %d: %s", r.label, domainID, err.Error()),
+		)
+		return
+	}
+
+	state.Domain = types.StringValue(domain.Domain)
+	state.ParentDomainID = types.Int64Value(int64(domain.ParentDomainID))
+	state.WebFolder = types.StringValue(domain.WebFolder)
+	state.DocumentRoot = types.StringValue(domain.DocumentRoot)
+	state.IPAddress = types.StringValue(domain.IPAddress)
+	state.IPv6Address = types.StringValue(domain.IPv6Address)
+	state.PHP = types.StringValue(domain.PHPVersion)
+	state.PHPVersion = types.StringValue(client.ParsePHPVersion(domain.FastcgiPHPVersion))
+	state.Active = types.BoolValue(ynToBool(domain.Active))
+	if domain.ServerID != 0 {
+		state.ServerID = types.Int64Value(int64(domain.ServerID))
+	}
+	state.SSL = types.BoolValue(ynToBool(domain.SSL))
+	state.Subdomain = types.StringValue(domain.Subdomain)
+	state.RedirectType = types.StringValue(domain.RedirectType)
+	state.RedirectPath = types.StringValue(domain.RedirectPath)
+	state.PM = types.StringValue(domain.PM)
+	state.PMProcessIdleTimeout = types.StringValue(domain.PMProcess)
+	state.PMMaxRequests = types.Int64Value(int64(domain.PMMaxRequests))
+	state.PHPOpenBasedir = types.StringValue(domain.PHPOpenBasedir)
+	state.ApacheDirectives = types.StringValue(domain.ApacheDirectives)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webVhostDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webVhostDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := int(plan.ID.ValueInt64())
+
+	domain, diags := r.buildWebDomain(ctx, &plan, clientID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateVhost(ctx, domainID, clientID, domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating "+r.label,
+			fmt.Sprintf("Could not update %s ID %d: %s", r.label, domainID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated "+r.label, map[string]interface{}{"id": domainID})
+
+	updatedDomain, err := r.getVhost(ctx, domainID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading updated "+r.label,
+			fmt.Sprintf("Could not read updated %s, unexpected error: %s", r.label, err.Error()),
+		)
+		return
+	}
+
+	r.setComputedValues(&plan, updatedDomain)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webVhostDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webVhostDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := int(state.ID.ValueInt64())
+
+	err := r.deleteVhost(ctx, domainID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting "+r.label,
+			fmt.Sprintf("Could not delete %s ID %d: %s", r.label, domainID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted "+r.label, map[string]interface{}{"id": domainID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
+}
+
+// ImportState imports the resource state.
+func (r *webVhostDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/procorp-solutions/ispconfig-terraform-provider/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webdavUserResource{}
+	_ resource.ResourceWithConfigure   = &webdavUserResource{}
+	_ resource.ResourceWithImportState = &webdavUserResource{}
+	_ resource.ResourceWithModifyPlan  = &webdavUserResource{}
+)
+
+// NewWebDAVUserResource is a helper function to simplify the provider implementation.
+func NewWebDAVUserResource() resource.Resource {
+	return &webdavUserResource{}
+}
+
+// webdavUserResource is the resource implementation.
+type webdavUserResource struct {
+	clientScope
+}
+
+// webdavUserResourceModel maps the resource schema data.
+type webdavUserResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	ClientID       types.Int64  `tfsdk:"client_id"`
+	ParentDomainID types.Int64  `tfsdk:"parent_domain_id"`
+	Username       types.String `tfsdk:"username"`
+	UsernamePrefix types.String `tfsdk:"username_prefix"`
+	Login          types.String `tfsdk:"login"`
+	Password       types.String `tfsdk:"password"`
+	Dir            types.String `tfsdk:"dir"`
+	Active         types.Bool   `tfsdk:"active"`
+	ServerID       types.Int64  `tfsdk:"server_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *webdavUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webdav_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *webdavUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a WebDAV user in ISP Config.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The ID of the WebDAV user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.Int64Attribute{
+				Description: "The ISP Config client ID.",
+				Optional:    true,
+			},
+			"parent_domain_id": schema.Int64Attribute{
+				Description: "The ID of the web hosting domain the WebDAV user belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The WebDAV username without prefix. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username_prefix": schema.StringAttribute{
+				Description: "The literal prefix prepended to the username. Defaults to the client's normalized username, " +
+					"matching ISPConfig's default webdavuser_prefix '[CLIENTNAME]'. Set to an empty string for no prefix. " +
+					"Changing this forces a new resource to be created.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"login": schema.StringAttribute{
+				Description: "The effective login name (username_prefix followed by username).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The WebDAV user password.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"dir": schema.StringAttribute{
+				Description: "The WebDAV directory, relative to the webdav folder of the parent domain.",
+				Required:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the WebDAV user is active.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "The server ID. Defaults to the server of the parent domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webdavUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ISPConfigProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ISPConfigProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.configure(providerData)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webdavUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webdavUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Create, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentDomainID := int(plan.ParentDomainID.ValueInt64())
+
+	// Resolve the username prefix the way the ISPConfig UI does; the remote
+	// API stores the username as given.
+	if plan.UsernamePrefix.IsNull() || plan.UsernamePrefix.IsUnknown() {
+		ispClient, err := r.client.GetClient(ctx, clientID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching client",
+				fmt.Sprintf("Could not fetch client ID %d to compute the username prefix: %s", clientID, err.Error()),
+			)
+			return
+		}
+		plan.UsernamePrefix = types.StringValue(replaceUsernamePrefix(defaultWebDAVUserPrefix, ispClient.Username, clientID, parentDomainID))
+	}
+	plan.Login = types.StringValue(plan.UsernamePrefix.ValueString() + plan.Username.ValueString())
+
+	webdavUser := &client.WebDAVUser{
+		ParentDomainID: client.FlexInt(parentDomainID),
+		Username:       plan.Login.ValueString(),
+		UsernamePrefix: plan.UsernamePrefix.ValueString(),
+		Password:       plan.Password.ValueString(),
+		Dir:            plan.Dir.ValueString(),
+		Active:         boolToYN(plan.Active.ValueBool()),
+	}
+
+	serverID, err := r.resolveServerID(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching parent domain",
+			"Could not fetch parent domain to determine the server: "+err.Error(),
+		)
+		return
+	}
+	webdavUser.ServerID = client.FlexInt(serverID)
+	plan.ServerID = types.Int64Value(int64(serverID))
+
+	// Create WebDAV user
+	webdavUserID, err := r.client.AddWebDAVUser(ctx, webdavUser, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating WebDAV user",
+			"Could not create WebDAV user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Created WebDAV user", map[string]interface{}{"id": webdavUserID, "login": plan.Login.ValueString()})
+
+	plan.ID = types.Int64Value(int64(webdavUserID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// resolveServerID returns the configured server ID, falling back to the
+// parent domain's server and then to the provider default.
+func (r *webdavUserResource) resolveServerID(ctx context.Context, plan webdavUserResourceModel) (int, error) {
+	if !plan.ServerID.IsNull() && !plan.ServerID.IsUnknown() {
+		return int(plan.ServerID.ValueInt64()), nil
+	}
+
+	parentDomain, err := r.client.GetWebDomain(ctx, int(plan.ParentDomainID.ValueInt64()))
+	if err != nil {
+		return 0, err
+	}
+	if parentDomain.ServerID != 0 {
+		return int(parentDomain.ServerID), nil
+	}
+	return r.serverID, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *webdavUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webdavUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webdavUserID := int(state.ID.ValueInt64())
+
+	webdavUser, err := r.client.GetWebDAVUser(ctx, webdavUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading WebDAV user",
+			fmt.Sprintf("Could not read WebDAV user ID %d: %s", webdavUserID, err.Error()),
+		)
+		return
+	}
+
+	// Update state
+	state.ParentDomainID = types.Int64Value(int64(webdavUser.ParentDomainID))
+	state.UsernamePrefix = types.StringValue(webdavUser.UsernamePrefix)
+	state.Username = types.StringValue(strings.TrimPrefix(webdavUser.Username, webdavUser.UsernamePrefix))
+	state.Login = types.StringValue(webdavUser.Username)
+	// Note: Password is not returned in plain text by the API, so we keep the existing value
+	state.Dir = types.StringValue(webdavUser.Dir)
+	state.Active = types.BoolValue(ynToBool(webdavUser.Active))
+	if webdavUser.ServerID != 0 {
+		state.ServerID = types.Int64Value(int64(webdavUser.ServerID))
+	} else if r.serverID != 0 {
+		state.ServerID = types.Int64Value(int64(r.serverID))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webdavUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webdavUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, clientID := r.startOperation(ctx, plan.Timeouts.Update, plan.ClientID, true, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webdavUserID := int(plan.ID.ValueInt64())
+
+	// username and username_prefix force replacement, so the login is unchanged
+	webdavUser := &client.WebDAVUser{
+		ParentDomainID: client.FlexInt(plan.ParentDomainID.ValueInt64()),
+		Username:       plan.Login.ValueString(),
+		UsernamePrefix: plan.UsernamePrefix.ValueString(),
+		Password:       plan.Password.ValueString(),
+		Dir:            plan.Dir.ValueString(),
+		Active:         boolToYN(plan.Active.ValueBool()),
+	}
+
+	serverID, err := r.resolveServerID(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching parent domain",
+			"Could not fetch parent domain to determine the server: "+err.Error(),
+		)
+		return
+	}
+	webdavUser.ServerID = client.FlexInt(serverID)
+	plan.ServerID = types.Int64Value(int64(serverID))
+
+	// Update WebDAV user
+	err = r.client.UpdateWebDAVUser(ctx, webdavUserID, clientID, webdavUser)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating WebDAV user",
+			fmt.Sprintf("Could not update WebDAV user ID %d: %s", webdavUserID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Updated WebDAV user", map[string]interface{}{"id": webdavUserID})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, plan.ServerID)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *webdavUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webdavUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, _ := r.startOperation(ctx, state.Timeouts.Delete, state.ClientID, false, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webdavUserID := int(state.ID.ValueInt64())
+
+	err := r.client.DeleteWebDAVUser(ctx, webdavUserID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting WebDAV user",
+			fmt.Sprintf("Could not delete WebDAV user ID %d: %s", webdavUserID, err.Error()),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted WebDAV user", map[string]interface{}{"id": webdavUserID})
+
+	// The resource is gone even if the servers have not caught up yet.
+	resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(waitForJobqueue(ctx, r.client, state.ServerID)...)
+}
+
+// ImportState imports the resource state. The password is not returned by
+// the API and has to be supplied in the configuration after import.
+func (r *webdavUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Convert the import ID (string) to int64
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID as integer: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}